@@ -81,6 +81,24 @@ func Window() BrowserWindow {
 //			http.ListenAndServe(":8080", nil)
 //	 }
 func RunWhenOnBrowser() {
+	runWhenOnBrowser(false)
+}
+
+// RunEmbeddedWhenOnBrowser starts the app in embed-only mode, where it only
+// runs the custom elements registered with RegisterElement. It is meant for
+// apps whose components are embedded into pages that are not rendered by
+// go-app.
+//
+// Unlike RunWhenOnBrowser, it does not display the component associated with
+// the current URL path, and leaves the navigation, the link clicks and the
+// language of the embedding page untouched.
+//
+// This call is skipped when the program is not run on a web browser.
+func RunEmbeddedWhenOnBrowser() {
+	runWhenOnBrowser(true)
+}
+
+func runWhenOnBrowser(embedded bool) {
 	if IsServer {
 		return
 	}
@@ -100,8 +118,14 @@ func RunWhenOnBrowser() {
 		&originPage,
 		actionHandlers,
 	)
+	engine.hydration = Getenv("GOAPP_HYDRATE") == "true"
+	engine.embedded = embedded
+	engine.initBrowser()
+	engine.defineCustomElements(customElements)
 
-	engine.Navigate(window.URL(), false)
+	if !embedded {
+		engine.Navigate(window.URL(), false)
+	}
 	engine.Start(120)
 }
 
@@ -99,10 +99,22 @@ func setJSAttribute(jsElement Value, name, value string) {
 		jsElement.Set(name, toBool(value))
 
 	default:
-		jsElement.Call("setAttribute", name, value)
+		jsElement.setAttr(name, value)
 	}
 }
 
+// setHTMLAttribute sets an attribute on the JS element of the given HTML
+// element. Elements that are not from the HTML namespace, such as SVG or MathML
+// elements, do not share the DOM properties of HTML elements and always have
+// their attributes set with setAttribute.
+func setHTMLAttribute(v HTML, name, value string) {
+	if v.XMLNamespace() != "" {
+		v.JSValue().setAttr(name, value)
+		return
+	}
+	setJSAttribute(v.JSValue(), name, value)
+}
+
 func deleteJSAttribute(jsElement Value, name string) {
-	jsElement.Call("removeAttribute", name)
+	jsElement.delAttr(name)
 }
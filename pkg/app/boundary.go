@@ -0,0 +1,107 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+// ErrorBoundary is the interface that describes a component that catches the
+// errors occurring within its subtree, preventing them from leaving the app
+// partially updated.
+//
+// Errors returned or panics raised while rendering, mounting or updating the
+// component and its descendants, as well as panics raised by functions
+// dispatched from them with Context.Dispatch, are reported to the closest
+// error boundary, which replaces its content with a fallback.
+//
+// Example:
+//
+//	type safe struct {
+//	    app.Compo
+//	}
+//
+//	func (c *safe) OnError(ctx app.Context, err errors.Error) app.UI {
+//	    return app.P().Text("something went wrong: " + err.Message)
+//	}
+type ErrorBoundary interface {
+	Composer
+
+	// OnError is called on the UI goroutine when an error occurs within the
+	// component subtree. It returns the UI element displayed in place of the
+	// component content until the component is rendered again. Returning nil
+	// forwards the error to the closest parent error boundary.
+	OnError(ctx Context, err errors.Error) UI
+}
+
+// CatchError reports the given error to the closest error boundary among the
+// given element and its ancestors, and displays the fallback it returns. It
+// returns the error when no boundary handles it.
+func (m nodeManager) CatchError(ctx Context, v UI, err error) error {
+	for boundary, ok := closestErrorBoundary(v); ok; boundary, ok = closestErrorBoundary(boundary.parent()) {
+		if err = m.catchError(ctx, boundary, err); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// catchError replaces the content of the given mounted error boundary with
+// the fallback it returns for the given error.
+func (m nodeManager) catchError(ctx Context, v ErrorBoundary, err error) error {
+	fallback, ok := m.errorFallback(ctx, v, err)
+	if !ok {
+		return err
+	}
+
+	if err := m.replaceComponentRoot(ctx, v, fallback); err != nil {
+		return errors.New("mounting error boundary fallback failed").
+			WithTag("type", reflect.TypeOf(v)).
+			WithTag("depth", v.depth()).
+			Wrap(err)
+	}
+
+	// Prevents an update queued before the error from replacing the fallback.
+	ctx.removeComponentUpdate(v)
+	return nil
+}
+
+// errorFallback returns the fallback of the given component for the given
+// error. It returns false when the component is not an error boundary or when
+// it does not provide a fallback.
+func (m nodeManager) errorFallback(ctx Context, v Composer, err error) (UI, bool) {
+	boundary, ok := v.(ErrorBoundary)
+	if !ok {
+		return nil, false
+	}
+
+	fallback := FilterUIElems(boundary.OnError(m.context(ctx, v), boundaryError(err)))
+	if len(fallback) == 0 {
+		return nil, false
+	}
+	return fallback[0], true
+}
+
+func closestErrorBoundary(v UI) (ErrorBoundary, bool) {
+	for ; v != nil; v = v.parent() {
+		if boundary, ok := v.(ErrorBoundary); ok {
+			return boundary, true
+		}
+	}
+	return nil, false
+}
+
+func boundaryError(err error) errors.Error {
+	if err, ok := err.(errors.Error); ok {
+		return err
+	}
+	return errors.New("error boundary caught an error").Wrap(err)
+}
+
+func panicError(msg string, v any) errors.Error {
+	if err, ok := v.(error); ok {
+		return errors.New(msg).Wrap(err)
+	}
+	return errors.New(msg).WithTag("panic", fmt.Sprint(v))
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type boundaryCompo struct {
+	Compo
+
+	child   UI
+	forward bool
+	err     errors.Error
+	errors  int
+}
+
+func (c *boundaryCompo) OnError(ctx Context, err errors.Error) UI {
+	c.err = err
+	c.errors++
+	if c.forward {
+		return nil
+	}
+	return P().Text("fallback")
+}
+
+func (c *boundaryCompo) Render() UI {
+	return Div().Body(c.child)
+}
+
+type faultyCompo struct {
+	Compo
+
+	fail bool
+}
+
+func (c *faultyCompo) Render() UI {
+	if c.fail {
+		panic("render failed")
+	}
+	return Span().Text("ok")
+}
+
+func TestErrorBoundary(t *testing.T) {
+	t.Run("render panic on mount displays fallback", func(t *testing.T) {
+		e := newTestEngine()
+		boundary := &boundaryCompo{child: &faultyCompo{fail: true}}
+		require.NoError(t, e.Load(boundary))
+		e.ConsumeAll()
+
+		require.Equal(t, 1, boundary.errors)
+		require.Equal(t, "mounting component root failed", boundary.err.Message)
+		require.Contains(t, boundary.err.Error(), "*app.faultyCompo")
+		require.Equal(t, "render failed", errors.Tag(boundary.err, "panic"))
+		require.NoError(t, Match(Text("fallback"), boundary, 0, 0))
+	})
+
+	t.Run("render panic on update displays fallback", func(t *testing.T) {
+		e := newTestEngine()
+		faulty := &faultyCompo{}
+		boundary := &boundaryCompo{child: faulty}
+		require.NoError(t, e.Load(boundary))
+		e.ConsumeAll()
+		require.NoError(t, Match(Text("ok"), boundary, 0, 0, 0, 0))
+
+		ctx := e.nodes.context(e.baseContext(), faulty)
+		ctx.Dispatch(func(ctx Context) {
+			faulty.fail = true
+		})
+		e.ConsumeAll()
+
+		require.Equal(t, 1, boundary.errors)
+		require.NoError(t, Match(Text("fallback"), boundary, 0, 0))
+		require.False(t, faulty.Mounted())
+	})
+
+	t.Run("dispatch panic displays fallback", func(t *testing.T) {
+		e := newTestEngine()
+		faulty := &faultyCompo{}
+		boundary := &boundaryCompo{child: faulty}
+		require.NoError(t, e.Load(boundary))
+		e.ConsumeAll()
+
+		ctx := e.nodes.context(e.baseContext(), faulty)
+		ctx.Dispatch(func(ctx Context) {
+			panic(errors.New("handler failed").WithTag("id", 42))
+		})
+		e.ConsumeAll()
+
+		require.Equal(t, 1, boundary.errors)
+		require.Equal(t, "dispatched function panicked", boundary.err.Message)
+		require.Equal(t, 42, errors.Tag(boundary.err, "id"))
+		require.NoError(t, Match(Text("fallback"), boundary, 0, 0))
+	})
+
+	t.Run("boundary without fallback forwards error to parent boundary", func(t *testing.T) {
+		e := newTestEngine()
+		inner := &boundaryCompo{
+			child:   &faultyCompo{fail: true},
+			forward: true,
+		}
+		outer := &boundaryCompo{child: inner}
+		require.NoError(t, e.Load(outer))
+		e.ConsumeAll()
+
+		require.Equal(t, 1, inner.errors)
+		require.Equal(t, 1, outer.errors)
+		require.False(t, inner.Mounted())
+		require.NoError(t, Match(Text("fallback"), outer, 0, 0))
+	})
+
+	t.Run("error without boundary is returned", func(t *testing.T) {
+		e := newTestEngine()
+		err := e.Load(&faultyCompo{fail: true})
+		require.Error(t, err)
+	})
+
+	t.Run("dispatch panic without boundary is not recovered", func(t *testing.T) {
+		e := newTestEngine()
+		faulty := &faultyCompo{}
+		require.NoError(t, e.Load(faulty))
+		e.ConsumeAll()
+
+		ctx := e.nodes.context(e.baseContext(), faulty)
+		ctx.Dispatch(func(ctx Context) {
+			panic("handler failed")
+		})
+		require.Panics(t, e.ConsumeAll)
+	})
+}
@@ -14,10 +14,13 @@ type browser struct {
 	resizeTimer      *time.Timer
 }
 
-func (b *browser) HandleEvents(ctx Context, notifyComponentEvent func(any)) {
+func (b *browser) HandleNavigation(ctx Context) {
 	b.handleAnchorClick(ctx)
 	b.handlePopState(ctx)
 	b.handleNavigationFromJS(ctx)
+}
+
+func (b *browser) HandleEvents(ctx Context, notifyComponentEvent func(any)) {
 	b.handleAppUpdate(ctx, notifyComponentEvent)
 	b.handleAppInstallChange(ctx, notifyComponentEvent)
 	b.handleAppResize(ctx, notifyComponentEvent)
@@ -25,7 +28,7 @@ func (b *browser) HandleEvents(ctx Context, notifyComponentEvent func(any)) {
 
 func (b *browser) handleAnchorClick(ctx Context) {
 	b.anchorClick = FuncOf(func(this Value, args []Value) any {
-		ctx.dispatch(func() {
+		ctx.dispatch(UserInputPriority, func() {
 			event := Event{Value: args[0]}
 
 			for target := event.Get("target"); target.Truthy(); target = target.Get("parentElement") {
@@ -61,7 +64,7 @@ func (b *browser) handleAnchorClick(ctx Context) {
 
 func (b *browser) handlePopState(ctx Context) {
 	b.popState = FuncOf(func(this Value, args []Value) any {
-		ctx.dispatch(func() {
+		ctx.dispatch(UserInputPriority, func() {
 			ctx.navigate(Window().URL(), false)
 		})
 		return nil
@@ -71,7 +74,7 @@ func (b *browser) handlePopState(ctx Context) {
 
 func (b *browser) handleNavigationFromJS(ctx Context) {
 	b.navigationFromJS = FuncOf(func(this Value, args []Value) any {
-		ctx.dispatch(func() {
+		ctx.dispatch(NormalPriority, func() {
 			ctx.Navigate(args[0].String())
 		})
 		return nil
@@ -81,7 +84,7 @@ func (b *browser) handleNavigationFromJS(ctx Context) {
 
 func (b *browser) handleAppUpdate(ctx Context, notifyComponentEvent func(any)) {
 	b.appUpdate = FuncOf(func(this Value, args []Value) any {
-		ctx.dispatch(func() {
+		ctx.dispatch(NormalPriority, func() {
 			b.AppUpdatable = true
 			notifyComponentEvent(appUpdate{})
 		})
@@ -95,7 +98,7 @@ func (b *browser) handleAppUpdate(ctx Context, notifyComponentEvent func(any)) {
 
 func (b *browser) handleAppInstallChange(ctx Context, notifyComponentEvent func(any)) {
 	b.appInstallChange = FuncOf(func(this Value, args []Value) any {
-		ctx.dispatch(func() {
+		ctx.dispatch(NormalPriority, func() {
 			notifyComponentEvent(appInstallChange{})
 		})
 		return nil
@@ -107,7 +110,7 @@ func (b *browser) handleAppResize(ctx Context, notifyComponentEvent func(any)) {
 	const resizeCooldown = time.Millisecond * 250
 
 	b.appResize = FuncOf(func(this Value, args []Value) any {
-		ctx.dispatch(func() {
+		ctx.dispatch(NormalPriority, func() {
 			if b.resizeTimer != nil {
 				b.resizeTimer.Stop()
 				b.resizeTimer.Reset(resizeCooldown)
@@ -115,7 +118,7 @@ func (b *browser) handleAppResize(ctx Context, notifyComponentEvent func(any)) {
 			}
 
 			b.resizeTimer = time.AfterFunc(resizeCooldown, func() {
-				ctx.dispatch(func() {
+				ctx.dispatch(NormalPriority, func() {
 					notifyComponentEvent(resize{})
 				})
 			})
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+	"github.com/maxence-charriere/go-app/v9/pkg/i18n"
 )
 
 // Composer defines a contract for creating custom, independent, and reusable
@@ -37,6 +38,11 @@ type Composer interface {
 	parent() UI
 	root() UI
 	setRoot(UI) Composer
+	setLang(*Signal[string])
+	trackSignal(*signalNode)
+	releaseSignals() []*signalNode
+	provide(reflect.Type, any)
+	provided(reflect.Type) (any, bool)
 }
 
 // Initializer describes a component that requires initialization
@@ -104,6 +110,22 @@ type Updater interface {
 	OnUpdate(Context)
 }
 
+// Memoizer describes components that decide whether they are updated when
+// their closest parent component is rendered again. Implementing the Memoizer
+// interface stops the update of a parent with unchanged inputs at the
+// component, sparing the rendering of its whole subtree. It is also suited for
+// components with function fields, which are otherwise always considered as
+// modified.
+type Memoizer interface {
+	// ShouldUpdate is called with the new version of the component produced by
+	// the parent rendering. It reports whether the exported fields of the
+	// component are replaced by the ones from next and the component rendered
+	// again. When true, the component is rendered again even if its fields are
+	// considered equal.
+	// This function always runs within the UI goroutine context.
+	ShouldUpdate(next Composer) bool
+}
+
 // AppUpdater defines components that are alerted when a newer version of the
 // application is downloaded in the background. Implementing this interface
 // allows components to proactively adapt to app updates, ensuring coherence
@@ -147,6 +169,17 @@ type Resizer interface {
 	OnResize(Context)
 }
 
+// Keyer is the interface that describes a component identified by a key
+// among its siblings. When the parent element is updated, keyed components are
+// matched with their previous instance by key rather than by position, which
+// preserves their state when they are reordered, inserted or removed.
+type Keyer interface {
+	Composer
+
+	// Key returns the value that identifies the component among its siblings.
+	Key() any
+}
+
 // UpdateNotifier defines a component that signals its parent component
 // regarding the requirement for an update in response to an HTML event.
 type UpdateNotifier interface {
@@ -162,10 +195,13 @@ type UpdateNotifier interface {
 // Compo serves as the foundational struct for constructing a component. It
 // provides basic methods and fields needed for component management.
 type Compo struct {
-	treeDepth     uint
-	ref           Composer
-	parentElement UI
-	rootElement   UI
+	treeDepth      uint
+	ref            Composer
+	parentElement  UI
+	rootElement    UI
+	signals        map[*signalNode]struct{}
+	providedValues map[reflect.Type]any
+	lang           *Signal[string]
 }
 
 // JSValue retrieves the JavaScript value associated with the component's root.
@@ -219,6 +255,42 @@ func (c *Compo) ValueTo(v any) EventHandler {
 	}
 }
 
+// Lang returns the language of the page and makes the component update when
+// it changes. It is meant to be called from the component Render method.
+func (c *Compo) Lang() string {
+	if c.lang == nil || c.ref == nil {
+		return langValue(c.lang)
+	}
+	return c.lang.Track(c.ref)
+}
+
+// Locale returns the locale that formats numbers, currencies and dates in the
+// language of the page, and makes the component update when it changes.
+func (c *Compo) Locale() i18n.Locale {
+	return i18n.Locale(c.Lang())
+}
+
+// T returns the message with the given key translated in the language of the
+// page, formatted with the given arguments, and makes the component update
+// when the language changes.
+//
+// Example:
+//
+//	func (c *hello) Render() app.UI {
+//	    return app.H1().Text(c.T("hello", c.name))
+//	}
+func (c *Compo) T(key string, args ...any) string {
+	return currentCatalog().Translate(c.Lang(), key, args...)
+}
+
+// TPlural returns the form of the message with the given key that corresponds
+// to the given quantity, translated in the language of the page and formatted
+// with the given arguments. The component is updated when the language
+// changes.
+func (c *Compo) TPlural(key string, n any, args ...any) string {
+	return currentCatalog().TranslatePlural(c.Lang(), key, n, args...)
+}
+
 func (c *Compo) setRef(v Composer) Composer {
 	c.ref = v
 	return v
@@ -250,3 +322,35 @@ func (c *Compo) setRoot(v UI) Composer {
 	c.rootElement = v
 	return c.ref
 }
+
+func (c *Compo) setLang(v *Signal[string]) {
+	c.lang = v
+}
+
+func (c *Compo) trackSignal(v *signalNode) {
+	if c.signals == nil {
+		c.signals = make(map[*signalNode]struct{})
+	}
+	c.signals[v] = struct{}{}
+}
+
+func (c *Compo) releaseSignals() []*signalNode {
+	signals := make([]*signalNode, 0, len(c.signals))
+	for s := range c.signals {
+		signals = append(signals, s)
+	}
+	c.signals = nil
+	return signals
+}
+
+func (c *Compo) provide(k reflect.Type, v any) {
+	if c.providedValues == nil {
+		c.providedValues = make(map[reflect.Type]any)
+	}
+	c.providedValues[k] = v
+}
+
+func (c *Compo) provided(k reflect.Type) (any, bool) {
+	v, ok := c.providedValues[k]
+	return v, ok
+}
@@ -110,6 +110,23 @@ func (b *bar) Render() UI {
 	return Text(b.Value)
 }
 
+type memoCompo struct {
+	Compo
+	Value   string
+	OnClick func()
+
+	renders int
+}
+
+func (c *memoCompo) ShouldUpdate(next Composer) bool {
+	return c.Value != next.(*memoCompo).Value
+}
+
+func (c *memoCompo) Render() UI {
+	c.renders++
+	return Text(c.Value)
+}
+
 type compoWithNilRendering struct {
 	Compo
 	NilOverride UI
@@ -165,3 +182,24 @@ func (c *navigatorComponent) OnNav(ctx Context) {
 		c.onNav(ctx)
 	}
 }
+
+type keyedCompo struct {
+	Compo
+
+	ID    int
+	Label string
+
+	dismounted bool
+}
+
+func (c *keyedCompo) Key() any {
+	return c.ID
+}
+
+func (c *keyedCompo) OnDismount() {
+	c.dismounted = true
+}
+
+func (c *keyedCompo) Render() UI {
+	return Li().Text(c.Label)
+}
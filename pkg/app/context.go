@@ -3,12 +3,15 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+	"github.com/maxence-charriere/go-app/v9/pkg/i18n"
 )
 
 // Context represents a UI element-associated environment enabling interactions
@@ -16,13 +19,15 @@ import (
 type Context struct {
 	context.Context
 
+	request               *http.Request
 	page                  func() Page
+	routeParams           func() RouteParams
 	appUpdatable          bool
 	resolveURL            func(string) string
 	navigate              func(*url.URL, bool)
 	localStorage          BrowserStorage
 	sessionStorage        BrowserStorage
-	dispatch              func(func())
+	dispatch              func(Priority, func())
 	defere                func(func())
 	async                 func(func())
 	addComponentUpdate    func(Composer)
@@ -33,9 +38,13 @@ type Context struct {
 	getState              func(Context, string, any)
 	setState              func(Context, string, any) State
 	delState              func(Context, string)
+	fetchData             func(Context, string, any, func(context.Context, any) error)
+	addStyles             func(Styler)
+	lang                  *Signal[string]
 
 	sourceElement        UI
 	notifyComponentEvent func(Context, UI, any)
+	catchError           func(Context, UI, error) error
 }
 
 // Src retrieves the linked UI element of the context.
@@ -90,6 +99,63 @@ func (ctx Context) Page() Page {
 	return ctx.page()
 }
 
+// Request returns the HTTP request that is served when the page is
+// pre-rendered on the server, giving access to its headers, cookies and remote
+// address. It returns nil in the browser.
+//
+// The context itself derives from the request context, making request
+// cancellation, deadlines and values set by middlewares available.
+func (ctx Context) Request() *http.Request {
+	return ctx.request
+}
+
+// RouteParams returns the parameters captured from the current page path by
+// the route pattern that matched it. It returns nil when the page was not
+// routed with RouteWithPattern or NamedRoute.
+func (ctx Context) RouteParams() RouteParams {
+	return ctx.routeParams()
+}
+
+// Lang returns the language of the page. When a catalog is set with
+// SetCatalog, it is negotiated between the catalog languages and the ones
+// preferred by the user.
+func (ctx Context) Lang() string {
+	return langValue(ctx.lang)
+}
+
+// SetLang sets the language of the page and updates the components that
+// translate messages with Compo.T or Compo.TPlural. In the browser, the
+// language is persisted and used for the next visits.
+func (ctx Context) SetLang(lang string) {
+	if ctx.lang != nil {
+		ctx.lang.Set(ctx, lang)
+	}
+	ctx.Page().SetLang(lang)
+
+	if IsClient {
+		persistLang(lang)
+	}
+}
+
+// Locale returns the locale that formats numbers, currencies and dates in the
+// language of the page.
+func (ctx Context) Locale() i18n.Locale {
+	return i18n.Locale(ctx.Lang())
+}
+
+// T returns the message with the given key translated in the language of the
+// page, formatted with the given arguments.
+func (ctx Context) T(key string, args ...any) string {
+	return currentCatalog().Translate(ctx.Lang(), key, args...)
+}
+
+// TPlural returns the form of the message with the given key that corresponds
+// to the given quantity, translated in the language of the page and formatted
+// with the given arguments.
+func (ctx Context) TPlural(key string, n any, args ...any) string {
+	return currentCatalog().TranslatePlural(ctx.Lang(), key, n, args...)
+}
+
 // Reload refreshes the present page.
 func (ctx Context) Reload() {
 	if IsServer {
@@ -128,6 +194,23 @@ func (ctx Context) ScrollTo(id string) {
 	})
 }
 
+// EmitCustomEvent dispatches a CustomEvent with the given name and detail from
+// the source element. The event bubbles, which allows parent elements and pages
+// that embed a component registered with RegisterElement to listen to it. The
+// detail is retrieved with Event.Detail.
+func (ctx Context) EmitCustomEvent(name string, detail any) {
+	if IsServer || !ctx.sourceElement.Mounted() {
+		return
+	}
+
+	event := Window().Get("CustomEvent").New(name, map[string]any{
+		"bubbles":  true,
+		"composed": true,
+		"detail":   jsPropertyValue(detail),
+	})
+	ctx.JSSrc().Call("dispatchEvent", event)
+}
+
 // LocalStorage accesses the browser's local storage tied to the document
 // origin.
 func (ctx Context) LocalStorage() BrowserStorage {
@@ -178,9 +261,18 @@ func (ctx Context) Notifications() NotificationService {
 
 // Dispatch prompts the execution of a function on the UI goroutine,
 // flagging the enclosing component for an update, respecting any
-// implemented UpdateNotifier behavior.
+// implemented UpdateNotifier behavior. A panic raised by the function is
+// reported to the closest ErrorBoundary when there is one.
 func (ctx Context) Dispatch(v func(Context)) {
-	ctx.dispatch(func() {
+	ctx.DispatchWithPriority(NormalPriority, v)
+}
+
+// DispatchWithPriority prompts the execution of a function on the UI goroutine
+// like Dispatch, with the given priority. Functions with a higher priority are
+// executed first, and functions with IdlePriority wait for the browser to be
+// idle.
+func (ctx Context) DispatchWithPriority(p Priority, v func(Context)) {
+	ctx.dispatch(p, func() {
 		if !ctx.sourceElement.Mounted() {
 			return
 		}
@@ -189,9 +281,22 @@ func (ctx Context) Dispatch(v func(Context)) {
 			ctx.addComponentUpdate(c)
 		}
 
-		if v != nil {
-			v(ctx)
+		if v == nil {
+			return
 		}
+
+		if _, ok := closestErrorBoundary(ctx.sourceElement); ok && ctx.catchError != nil {
+			defer func() {
+				if r := recover(); r != nil {
+					err := panicError("dispatched function panicked", r).
+						WithTag("source-type", reflect.TypeOf(ctx.sourceElement))
+					if err := ctx.catchError(ctx, ctx.sourceElement, err); err != nil {
+						panic(err)
+					}
+				}
+			}()
+		}
+		v(ctx)
 	})
 }
 
@@ -215,6 +320,32 @@ func (ctx Context) Async(v func()) {
 	ctx.async(v)
 }
 
+// FetchData fills v, a pointer, with the data identified by the given key.
+//
+// The fetch function is called in a separate goroutine with a pointer to a new
+// value of the same type as v. Once it returns without error, the value is
+// stored into v on the UI goroutine and the enclosing component is updated.
+//
+// When pre-rendering on the server, the page is encoded once all the data is
+// fetched or when the request context or Handler.PreRenderTimeout is done. The
+// fetched data is embedded into the page and used in the browser for the same
+// key instead of calling fetch again.
+//
+// Example:
+//
+//	func (p *post) OnPreRender(ctx app.Context) { p.load(ctx) }
+//	func (p *post) OnMount(ctx app.Context)     { p.load(ctx) }
+//
+//	func (p *post) load(ctx app.Context) {
+//	    id := ctx.RouteParams()["id"]
+//	    ctx.FetchData("post/"+id, &p.post, func(ctx context.Context, v any) error {
+//	        return getPost(ctx, id, v.(*Post))
+//	    })
+//	}
+func (ctx Context) FetchData(key string, v any, fetch func(ctx context.Context, v any) error) {
+	ctx.fetchData(ctx, key, v, fetch)
+}
+
 // After pauses for a determined span, then triggers a specified function.
 func (ctx Context) After(d time.Duration, f func(Context)) {
 	ctx.async(func() {
@@ -205,6 +205,26 @@ func TestContextDispatch(t *testing.T) {
 	})
 }
 
+func TestContextDispatchWithPriority(t *testing.T) {
+	e := newTestEngine()
+
+	hello := &hello{}
+	e.Load(hello)
+	e.ConsumeAll()
+
+	var calls []Priority
+	ctx := e.nodes.context(e.baseContext(), hello)
+	for _, p := range []Priority{IdlePriority, NormalPriority, UserInputPriority} {
+		p := p
+		ctx.DispatchWithPriority(p, func(ctx Context) {
+			calls = append(calls, p)
+		})
+	}
+
+	e.ConsumeAll()
+	require.Equal(t, []Priority{UserInputPriority, NormalPriority, IdlePriority}, calls)
+}
+
 func TestContextDefer(t *testing.T) {
 	t.Run("function is executed when source element is mounted", func(t *testing.T) {
 		e := newTestEngine()
@@ -361,10 +381,11 @@ func makeTestContext() Context {
 	return Context{
 		Context:               context.Background(),
 		page:                  func() Page { return page },
+		routeParams:           func() RouteParams { return nil },
 		resolveURL:            resolveURL,
 		localStorage:          localStorage,
 		sessionStorage:        sessionStorage,
-		dispatch:              func(f func()) { f() },
+		dispatch:              func(p Priority, f func()) { f() },
 		defere:                func(f func()) { f() },
 		async:                 func(f func()) { f() },
 		addComponentUpdate:    func(Composer) {},
@@ -0,0 +1,177 @@
+package app
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+const (
+	// The JavaScript property that identifies a mounted custom element.
+	customElementIDProperty = "goappElementID"
+)
+
+var customElements = make(map[string]customElement)
+
+// RegisterElement registers the component created by the given function as a
+// custom element with the given tag, which allows it to be embedded into
+// pages that are not rendered by go-app, as long as they load the app.js
+// script.
+//
+// The component is mounted into the element when the element is connected to
+// the document, and dismounted when the element is removed. Exported fields
+// with an "attr" struct tag are set from the element attributes with the same
+// name, and the component is updated when they change. Events can be sent to
+// the embedding page with Context.EmitCustomEvent.
+//
+// The tag must be lowercase and contain a hyphen. It panics when the tag is
+// not valid or when the component is not a pointer to a struct.
+//
+// Example:
+//
+//	type counter struct {
+//	    app.Compo
+//
+//	    Label string `attr:"label"`
+//	    Start int    `attr:"start"`
+//	}
+//
+//	app.RegisterElement("my-counter", func() app.Composer {
+//	    return &counter{}
+//	})
+//
+// The component can then be embedded in any page:
+//
+//	<my-counter label="Clicks" start="42"></my-counter>
+//	<script src="/app.js"></script>
+//
+// Apps that only provide custom elements to such pages are started with
+// RunEmbeddedWhenOnBrowser instead of RunWhenOnBrowser, which leaves the
+// navigation and the language of the embedding page untouched.
+func RegisterElement(tag string, newComponent func() Composer) {
+	if !isCustomElementTag(tag) {
+		panic(errors.New("custom element tag is not valid").
+			WithTag("tag", tag).
+			WithTag("reason", "tag must be lowercase and contain a hyphen"))
+	}
+
+	t := reflect.TypeOf(newComponent())
+	if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		panic(errors.New("custom element component is not a pointer to a struct").
+			WithTag("tag", tag).
+			WithTag("type", t))
+	}
+
+	customElements[tag] = customElement{
+		tag:          tag,
+		newComponent: newComponent,
+		attributes:   customElementAttributes(t.Elem()),
+	}
+}
+
+func isCustomElementTag(tag string) bool {
+	if tag == "" || !strings.Contains(tag, "-") || tag[0] < 'a' || tag[0] > 'z' {
+		return false
+	}
+	for _, c := range tag {
+		if c >= 'A' && c <= 'Z' || c == ' ' || c == '>' || c == '/' {
+			return false
+		}
+	}
+	return true
+}
+
+// customElement describes a component registered as a custom element.
+type customElement struct {
+	tag          string
+	newComponent func() Composer
+	attributes   map[string][]int
+}
+
+// customElementAttributes returns the index of the struct fields bound to
+// attributes, indexed by attribute name.
+func customElementAttributes(t reflect.Type) map[string][]int {
+	attributes := make(map[string][]int)
+	for _, f := range reflect.VisibleFields(t) {
+		name := f.Tag.Get("attr")
+		if !f.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		attributes[name] = f.Index
+	}
+	return attributes
+}
+
+// observedAttributes returns the names of the attributes bound to the
+// component fields, sorted by name.
+func (e customElement) observedAttributes() []any {
+	names := make([]string, 0, len(e.attributes))
+	for name := range e.attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attributes := make([]any, len(names))
+	for i, name := range names {
+		attributes[i] = name
+	}
+	return attributes
+}
+
+// setAttribute sets the component field bound to the given attribute. A
+// boolean field is true when the attribute is present, unless its value is
+// "false".
+func (e customElement) setAttribute(v Composer, name, value string, present bool) error {
+	index, ok := e.attributes[name]
+	if !ok {
+		return nil
+	}
+
+	field := reflect.ValueOf(v).Elem().FieldByIndex(index)
+	switch {
+	case field.Kind() == reflect.Bool:
+		field.SetBool(present && value != "false")
+
+	case !present:
+		field.SetZero()
+
+	default:
+		if err := parseFormValue(name, value, field, defaultFormTimeLayout); err != nil {
+			return errors.New("setting custom element attribute failed").
+				WithTag("tag", e.tag).
+				WithTag("attribute", name).
+				WithTag("value", value).
+				Wrap(err)
+		}
+	}
+	return nil
+}
+
+// customElementManager tracks the custom elements mounted by an engine.
+type customElementManager struct {
+	lastID    int
+	instances map[int]Composer
+}
+
+// Add records the given mounted component and returns its id.
+func (m *customElementManager) Add(v Composer) int {
+	if m.instances == nil {
+		m.instances = make(map[int]Composer)
+	}
+	m.lastID++
+	m.instances[m.lastID] = v
+	return m.lastID
+}
+
+// Get returns the mounted component with the given id.
+func (m *customElementManager) Get(id int) (Composer, bool) {
+	v, ok := m.instances[id]
+	return v, ok
+}
+
+// Remove removes the mounted component with the given id.
+func (m *customElementManager) Remove(id int) {
+	delete(m.instances, id)
+}
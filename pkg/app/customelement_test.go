@@ -0,0 +1,158 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type customElementCompo struct {
+	Compo
+
+	Label    string    `attr:"label"`
+	Count    int       `attr:"count"`
+	Disabled bool      `attr:"disabled"`
+	Since    time.Time `attr:"since"`
+	Ignored  string
+	internal string `attr:"internal"`
+}
+
+func TestRegisterElement(t *testing.T) {
+	defer delete(customElements, "x-test")
+
+	t.Run("component is registered", func(t *testing.T) {
+		RegisterElement("x-test", func() Composer {
+			return &customElementCompo{}
+		})
+		require.Contains(t, customElements, "x-test")
+		require.Equal(t, []any{"count", "disabled", "label", "since"}, customElements["x-test"].observedAttributes())
+	})
+
+	t.Run("invalid tag panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			RegisterElement("xtest", func() Composer {
+				return &customElementCompo{}
+			})
+		})
+	})
+
+	t.Run("non struct pointer component panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			RegisterElement("x-nil", func() Composer {
+				return nil
+			})
+		})
+	})
+}
+
+func TestIsCustomElementTag(t *testing.T) {
+	utests := []struct {
+		tag      string
+		expected bool
+	}{
+		{tag: "my-element", expected: true},
+		{tag: "x-1", expected: true},
+		{tag: "", expected: false},
+		{tag: "div", expected: false},
+		{tag: "My-element", expected: false},
+		{tag: "my-Element", expected: false},
+		{tag: "-element", expected: false},
+		{tag: "my element-", expected: false},
+	}
+
+	for _, u := range utests {
+		t.Run(u.tag, func(t *testing.T) {
+			require.Equal(t, u.expected, isCustomElementTag(u.tag))
+		})
+	}
+}
+
+func TestCustomElementSetAttribute(t *testing.T) {
+	element := customElement{
+		tag:        "x-test",
+		attributes: customElementAttributes(reflect.TypeOf(customElementCompo{})),
+	}
+
+	t.Run("string attribute is set", func(t *testing.T) {
+		var compo customElementCompo
+		err := element.setAttribute(&compo, "label", "hello", true)
+		require.NoError(t, err)
+		require.Equal(t, "hello", compo.Label)
+	})
+
+	t.Run("int attribute is set", func(t *testing.T) {
+		var compo customElementCompo
+		err := element.setAttribute(&compo, "count", "42", true)
+		require.NoError(t, err)
+		require.Equal(t, 42, compo.Count)
+	})
+
+	t.Run("date attribute is set", func(t *testing.T) {
+		var compo customElementCompo
+		err := element.setAttribute(&compo, "since", "2024-03-01", true)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), compo.Since)
+	})
+
+	t.Run("bool attribute is set when present", func(t *testing.T) {
+		var compo customElementCompo
+		err := element.setAttribute(&compo, "disabled", "", true)
+		require.NoError(t, err)
+		require.True(t, compo.Disabled)
+
+		err = element.setAttribute(&compo, "disabled", "false", true)
+		require.NoError(t, err)
+		require.False(t, compo.Disabled)
+
+		compo.Disabled = true
+		err = element.setAttribute(&compo, "disabled", "", false)
+		require.NoError(t, err)
+		require.False(t, compo.Disabled)
+	})
+
+	t.Run("removed attribute resets the field", func(t *testing.T) {
+		compo := customElementCompo{Label: "hello", Count: 42}
+		err := element.setAttribute(&compo, "label", "", false)
+		require.NoError(t, err)
+		require.Empty(t, compo.Label)
+		require.Equal(t, 42, compo.Count)
+	})
+
+	t.Run("unbound attribute is ignored", func(t *testing.T) {
+		var compo customElementCompo
+		err := element.setAttribute(&compo, "internal", "hello", true)
+		require.NoError(t, err)
+		require.Empty(t, compo.internal)
+	})
+
+	t.Run("invalid value returns an error", func(t *testing.T) {
+		var compo customElementCompo
+		err := element.setAttribute(&compo, "count", "forty-two", true)
+		require.Error(t, err)
+	})
+}
+
+func TestCustomElementManager(t *testing.T) {
+	var m customElementManager
+
+	foo := &customElementCompo{}
+	bar := &customElementCompo{}
+
+	fooID := m.Add(foo)
+	barID := m.Add(bar)
+	require.NotEqual(t, fooID, barID)
+
+	v, ok := m.Get(fooID)
+	require.True(t, ok)
+	require.Same(t, foo, v)
+
+	m.Remove(fooID)
+	_, ok = m.Get(fooID)
+	require.False(t, ok)
+
+	v, ok = m.Get(barID)
+	require.True(t, ok)
+	require.Same(t, bar, v)
+}
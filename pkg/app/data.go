@@ -0,0 +1,164 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+const (
+	// The id of the script element that carries the data fetched during
+	// pre-rendering.
+	pageDataID = "goapp-data"
+)
+
+// dataManager tracks the data fetched with Context.FetchData. On the server,
+// it waits for pending fetches and collects their results so they can be
+// embedded into the pre-rendered page. In the browser, it provides the
+// embedded results back, preventing the same data from being fetched twice.
+type dataManager struct {
+	mutex        sync.Mutex
+	values       map[string]json.RawMessage
+	pending      sync.WaitGroup
+	count        atomic.Int64
+	prerendering bool
+}
+
+// Fetch fills the given receiver with the data identified by key. The data is
+// taken from the values received from the server when available, otherwise it
+// is fetched in a separate goroutine and stored into the receiver on the UI
+// goroutine.
+func (m *dataManager) Fetch(ctx Context, key string, receiver any, fetch func(context.Context, any) error) {
+	if m.take(key, receiver) {
+		ctx.Dispatch(nil)
+		return
+	}
+
+	receiverType := reflect.TypeOf(receiver)
+	if receiverType == nil || receiverType.Kind() != reflect.Pointer {
+		Log(errors.New("fetching data failed").
+			WithTag("key", key).
+			WithTag("receiver-type", receiverType).
+			Wrap(errors.New("receiver is not a pointer")))
+		return
+	}
+
+	m.pending.Add(1)
+	m.count.Add(1)
+	go func() {
+		defer m.count.Add(-1)
+		defer m.pending.Done()
+
+		value := reflect.New(receiverType.Elem()).Interface()
+		if err := fetch(ctx, value); err != nil {
+			Log(errors.New("fetching data failed").
+				WithTag("key", key).
+				Wrap(err))
+			return
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		ctx.Dispatch(func(ctx Context) {
+			if err := storeValue(receiver, value); err != nil {
+				Log(errors.New("storing fetched data failed").
+					WithTag("key", key).
+					Wrap(err))
+				return
+			}
+			m.set(key, value)
+		})
+	}()
+}
+
+// Wait blocks until the pending fetches are done or the given context is
+// canceled.
+func (m *dataManager) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		m.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Pending reports whether fetches are in progress.
+func (m *dataManager) Pending() bool {
+	return m.count.Load() > 0
+}
+
+// Encode returns the JSON representation of the fetched data.
+func (m *dataManager) Encode() ([]byte, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if len(m.values) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(m.values)
+}
+
+// Decode loads the fetched data from the given JSON representation.
+func (m *dataManager) Decode(data []byte) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return json.Unmarshal(data, &m.values)
+}
+
+func (m *dataManager) set(key string, value any) {
+	if !m.prerendering {
+		return
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		Log(errors.New("encoding fetched data failed").
+			WithTag("key", key).
+			Wrap(err))
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.values == nil {
+		m.values = make(map[string]json.RawMessage)
+	}
+	m.values[key] = b
+}
+
+func (m *dataManager) take(key string, receiver any) bool {
+	if m.prerendering {
+		return false
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	value, ok := m.values[key]
+	if !ok {
+		return false
+	}
+	delete(m.values, key)
+
+	if err := json.Unmarshal(value, receiver); err != nil {
+		Log(errors.New("decoding pre-rendered data failed").
+			WithTag("key", key).
+			Wrap(err))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type dataCompo struct {
+	Compo
+
+	Greeting string
+	fetches  int
+}
+
+func (c *dataCompo) OnPreRender(ctx Context) {
+	c.load(ctx)
+}
+
+func (c *dataCompo) OnMount(ctx Context) {
+	c.load(ctx)
+}
+
+func (c *dataCompo) load(ctx Context) {
+	ctx.FetchData("greeting", &c.Greeting, func(ctx context.Context, v any) error {
+		c.fetches++
+		*v.(*string) = "hello"
+		return nil
+	})
+}
+
+func (c *dataCompo) Render() UI {
+	return Div().Text(c.Greeting)
+}
+
+func TestDataManagerFetch(t *testing.T) {
+	t.Run("fetched data is stored into the receiver", func(t *testing.T) {
+		e := newTestEngine()
+		compo := &dataCompo{}
+		e.Load(compo)
+		e.ConsumeAll()
+		require.NoError(t, e.ConsumeData())
+
+		require.Equal(t, "hello", compo.Greeting)
+		require.Equal(t, 1, compo.fetches)
+		require.NoError(t, Match(Text("hello"), compo, 0, 0))
+	})
+
+	t.Run("fetched data is collected when pre-rendering", func(t *testing.T) {
+		e := newTestEngine()
+		e.data.prerendering = true
+		e.Load(&dataCompo{})
+		e.ConsumeAll()
+		require.NoError(t, e.ConsumeData())
+
+		data, err := e.data.Encode()
+		require.NoError(t, err)
+		require.JSONEq(t, `{"greeting":"hello"}`, string(data))
+	})
+
+	t.Run("fetched data is not collected when not pre-rendering", func(t *testing.T) {
+		e := newTestEngine()
+		e.Load(&dataCompo{})
+		e.ConsumeAll()
+		require.NoError(t, e.ConsumeData())
+
+		data, err := e.data.Encode()
+		require.NoError(t, err)
+		require.Empty(t, data)
+	})
+
+	t.Run("pre-rendered data is used instead of fetching", func(t *testing.T) {
+		e := newTestEngine()
+		require.NoError(t, e.data.Decode([]byte(`{"greeting":"bonjour"}`)))
+
+		compo := &dataCompo{}
+		e.Load(compo)
+		e.ConsumeAll()
+		require.NoError(t, e.ConsumeData())
+		require.Equal(t, "bonjour", compo.Greeting)
+		require.Zero(t, compo.fetches)
+	})
+
+	t.Run("pre-rendered data is used once", func(t *testing.T) {
+		e := newTestEngine()
+		require.NoError(t, e.data.Decode([]byte(`{"greeting":"bonjour"}`)))
+
+		var greeting string
+		require.True(t, e.data.take("greeting", &greeting))
+		require.Equal(t, "bonjour", greeting)
+		require.False(t, e.data.take("greeting", &greeting))
+	})
+
+	t.Run("fetch error is logged", func(t *testing.T) {
+		e := newTestEngine()
+		compo := &hello{}
+		e.Load(compo)
+
+		ctx := e.baseContext()
+		ctx.sourceElement = compo
+
+		var greeting string
+		e.data.Fetch(ctx, "greeting", &greeting, func(ctx context.Context, v any) error {
+			return errors.New("test")
+		})
+		require.NoError(t, e.ConsumeData())
+		require.Empty(t, greeting)
+	})
+
+	t.Run("non pointer receiver is not fetched", func(t *testing.T) {
+		var m dataManager
+		m.Fetch(makeTestContext(), "greeting", "", func(ctx context.Context, v any) error {
+			return nil
+		})
+		require.False(t, m.Pending())
+	})
+}
+
+func TestDataManagerWait(t *testing.T) {
+	t.Run("wait returns when fetches are done", func(t *testing.T) {
+		var m dataManager
+		require.NoError(t, m.Wait(context.Background()))
+	})
+
+	t.Run("wait returns when context is done", func(t *testing.T) {
+		var m dataManager
+		release := make(chan struct{})
+		defer close(release)
+
+		ctx := makeTestContext()
+		ctx.sourceElement = &hello{}
+
+		var greeting string
+		m.Fetch(ctx, "greeting", &greeting, func(ctx context.Context, v any) error {
+			<-release
+			return nil
+		})
+		require.True(t, m.Pending())
+
+		waitCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+		require.Error(t, m.Wait(waitCtx))
+	})
+}
@@ -0,0 +1,184 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// domOp is a DOM operation recorded in a domCommands buffer. Operations are
+// applied by goappApplyDOMCommands in gen/app.js, which gets their codes and
+// number of arguments from domOps when app.js is generated.
+type domOp int
+
+const (
+	domCreateElement domOp = iota
+	domCreateTextNode
+	domSetAttr
+	domDelAttr
+	domSetProperty
+	domAppendChild
+	domInsertBefore
+	domReplaceChild
+	domRemoveChild
+	domSetNodeValue
+	domSetInnerHTML
+	domSetInnerText
+	domAddEventListener
+	domRemoveEventListener
+	domReleaseNode
+	domTransitionEnter
+	domTransitionLeave
+	domTransitionMove
+)
+
+// domOps describes the DOM operations, indexed by operation. Names are the
+// ones used by gen/app.js to refer to the operations.
+var domOps = [...]struct {
+	name string
+	args int
+}{
+	domCreateElement:       {"createElement", 3},       // id, tag, xmlns
+	domCreateTextNode:      {"createTextNode", 2},      // id, value
+	domSetAttr:             {"setAttr", 3},             // node, name, value
+	domDelAttr:             {"delAttr", 2},             // node, name
+	domSetProperty:         {"setProperty", 3},         // node, name, value
+	domAppendChild:         {"appendChild", 2},         // parent, child
+	domInsertBefore:        {"insertBefore", 3},        // parent, new, ref
+	domReplaceChild:        {"replaceChild", 3},        // parent, new, old
+	domRemoveChild:         {"removeChild", 2},         // parent, child
+	domSetNodeValue:        {"setNodeValue", 2},        // node, value
+	domSetInnerHTML:        {"setInnerHTML", 2},        // node, value
+	domSetInnerText:        {"setInnerText", 2},        // node, value
+	domAddEventListener:    {"addEventListener", 3},    // node, event, func
+	domRemoveEventListener: {"removeEventListener", 3}, // node, event, func
+	domReleaseNode:         {"releaseNode", 1},         // id
+	domTransitionEnter:     {"transitionEnter", 2},     // node, name
+	domTransitionLeave:     {"transitionLeave", 3},     // parent, child, name
+	domTransitionMove:      {"transitionMove", 2},      // node, name
+}
+
+// domOpsJSON returns the JSON objects that app.js uses to interpret DOM
+// commands: the codes of the operations indexed by name, and their number of
+// arguments indexed by code.
+func domOpsJSON() (codes, args string) {
+	c := make(map[string]int, len(domOps))
+	a := make([]int, len(domOps))
+	for op, o := range domOps {
+		c[o.name] = op
+		a[op] = o.args
+	}
+	return jsonString(c), jsonString(a)
+}
+
+// domCommands is a buffer that records DOM operations in order to apply them
+// with a single JavaScript call, instead of crossing the boundary between Go
+// and JavaScript for each of them.
+//
+// Commands are encoded as a flat JSON array. Nodes created by the buffer are
+// referenced by a positive id. Other JavaScript values, such as nodes that
+// were not created by the buffer or functions, are passed along with the
+// commands and referenced by a negative number: -1 for the first value, -2 for
+// the second, and so on.
+type domCommands struct {
+	mutex    sync.Mutex
+	commands bytes.Buffer
+	values   []any
+	lastID   int
+}
+
+// NewNode returns the id of a new node.
+func (c *domCommands) NewNode() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.lastID++
+	return c.lastID
+}
+
+// Record records the given operation with the given arguments, which must be
+// integers, strings or booleans. Other arguments are encoded as null.
+func (c *domCommands) Record(op domOp, args ...any) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.encode(int(op))
+	for _, arg := range args {
+		c.encode(arg)
+	}
+}
+
+// Value registers the given JavaScript value to be passed along with the
+// commands, and returns the number that references it.
+func (c *domCommands) Value(v any) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.values = append(c.values, v)
+	return -len(c.values)
+}
+
+// Len returns the size of the encoded commands.
+func (c *domCommands) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.commands.Len()
+}
+
+// Flush returns the encoded commands and the values they reference, and
+// resets the buffer. It returns false when no commands were recorded.
+func (c *domCommands) Flush() (string, []any, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.commands.Len() == 0 {
+		return "", nil, false
+	}
+
+	c.commands.WriteByte(']')
+	commands := c.commands.String()
+	values := c.values
+	c.commands.Reset()
+	c.values = nil
+	return commands, values, true
+}
+
+func (c *domCommands) encode(v any) {
+	if c.commands.Len() == 0 {
+		c.commands.WriteByte('[')
+	} else {
+		c.commands.WriteByte(',')
+	}
+
+	switch v := v.(type) {
+	case int:
+		c.commands.WriteString(strconv.Itoa(v))
+
+	case string:
+		b, _ := json.Marshal(v)
+		c.commands.Write(b)
+
+	case bool:
+		c.commands.WriteString(strconv.FormatBool(v))
+
+	default:
+		c.commands.WriteString("null")
+	}
+}
+
+// isValidTagName reports whether the given tag can be used to create an
+// element.
+func isValidTagName(tag string) bool {
+	if tag == "" {
+		return false
+	}
+
+	switch c := tag[0]; {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == ':':
+	default:
+		return false
+	}
+	return !strings.ContainsAny(tag, " \t\n\f\r\x00/<>=\"'")
+}
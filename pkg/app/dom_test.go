@@ -0,0 +1,134 @@
+package app
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDOMCommands(t *testing.T) {
+	t.Run("commands are encoded", func(t *testing.T) {
+		var c domCommands
+
+		div := c.NewNode()
+		text := c.NewNode()
+		body := c.Value("body")
+		require.Equal(t, 1, div)
+		require.Equal(t, 2, text)
+		require.Equal(t, -1, body)
+
+		c.Record(domCreateElement, div, "div", "")
+		c.Record(domCreateTextNode, text, `say "hello"`)
+		c.Record(domAppendChild, div, text)
+		c.Record(domSetProperty, div, "hidden", true)
+		c.Record(domSetProperty, div, "tabIndex", 2.5)
+		c.Record(domAppendChild, body, div)
+		require.NotZero(t, c.Len())
+
+		commands, values, ok := c.Flush()
+		require.True(t, ok)
+		require.Equal(t, `[0,1,"div","",1,2,"say \"hello\"",5,1,2,4,1,"hidden",true,4,1,"tabIndex",null,5,-1,1]`, commands)
+		require.Equal(t, []any{"body"}, values)
+	})
+
+	t.Run("transition commands are encoded", func(t *testing.T) {
+		var c domCommands
+
+		item := c.NewNode()
+		list := c.Value("list")
+		c.Record(domTransitionEnter, item, "fade")
+		c.Record(domTransitionMove, item, "fade")
+		c.Record(domTransitionLeave, list, item, "fade")
+
+		commands, values, ok := c.Flush()
+		require.True(t, ok)
+		require.Equal(t, `[15,1,"fade",17,1,"fade",16,-1,1,"fade"]`, commands)
+		require.Equal(t, []any{"list"}, values)
+	})
+
+	t.Run("flush resets the buffer", func(t *testing.T) {
+		var c domCommands
+
+		c.Record(domReleaseNode, c.NewNode())
+		_, _, ok := c.Flush()
+		require.True(t, ok)
+		require.Zero(t, c.Len())
+
+		_, _, ok = c.Flush()
+		require.False(t, ok)
+
+		c.Record(domReleaseNode, c.NewNode())
+		commands, values, ok := c.Flush()
+		require.True(t, ok)
+		require.Equal(t, "[14,2]", commands)
+		require.Empty(t, values)
+	})
+
+	t.Run("operations are encoded for app.js", func(t *testing.T) {
+		codes, args := domOpsJSON()
+		require.Contains(t, codes, `"createElement":0`)
+		require.Contains(t, codes, `"transitionMove":17`)
+		require.Equal(t, "[3,2,3,2,3,2,3,3,2,2,2,2,3,3,1,2,3,2]", args)
+	})
+
+	t.Run("operations are applied by app.js", func(t *testing.T) {
+		require.Contains(t, appJS, "const goappDOMOps = {{.DOMOps}};")
+		require.Contains(t, appJS, "const goappDOMCommandArgs = {{.DOMCommandArgs}};")
+
+		handled := make(map[string]bool)
+		for _, m := range regexp.MustCompile(`case goappDOMOps\.(\w+):`).FindAllStringSubmatch(appJS, -1) {
+			handled[m[1]] = true
+		}
+		for _, o := range domOps {
+			require.True(t, handled[o.name], "%s is not handled by app.js", o.name)
+			delete(handled, o.name)
+		}
+		require.Empty(t, handled)
+	})
+}
+
+func TestIsValidTagName(t *testing.T) {
+	utests := []struct {
+		scenario string
+		tag      string
+		expected bool
+	}{
+		{
+			scenario: "html tag",
+			tag:      "div",
+			expected: true,
+		},
+		{
+			scenario: "custom element tag",
+			tag:      "my-element",
+			expected: true,
+		},
+		{
+			scenario: "namespaced tag",
+			tag:      "svg:circle",
+			expected: true,
+		},
+		{
+			scenario: "empty tag",
+		},
+		{
+			scenario: "tag starting with a digit",
+			tag:      "1div",
+		},
+		{
+			scenario: "tag with a space",
+			tag:      "my div",
+		},
+		{
+			scenario: "tag with markup",
+			tag:      "div><script",
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			require.Equal(t, u.expected, isValidTagName(u.tag))
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package app
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -14,7 +17,8 @@ import (
 )
 
 type engineX struct {
-	ctx context.Context
+	ctx     context.Context
+	request *http.Request
 
 	localStorage   BrowserStorage
 	sessionStorage BrowserStorage
@@ -25,18 +29,30 @@ type engineX struct {
 	resolveURL     func(string) string
 	originPage     *requestPage
 	lastVisitedURL *url.URL
+	params         RouteParams
+	layouts        []mountedLayout
+	hydration      bool
+	embedded       bool
 
 	nodes   nodeManager
 	updates updateManager
 	body    HTMLBody
+	lang    *Signal[string]
 
-	dispatches chan func()
-	defers     chan func()
-	goroutines sync.WaitGroup
+	scheduler     *scheduler
+	defers        taskQueue
+	goroutines    sync.WaitGroup
+	frameBudget   time.Duration
+	idleRequested bool
+	idleDeadlines chan time.Time
+	idleCallback  Func
 
 	asynchronousActionHandlers map[string]ActionHandler
 	actions                    actionManager
 	states                     stateManager
+	data                       dataManager
+	styles                     styleManager
+	elements                   customElementManager
 }
 
 func newEngine(ctx context.Context, routes *router, resolveURL func(string) string, originPage *requestPage, actionHandlers map[string]ActionHandler) *engineX {
@@ -64,21 +80,23 @@ func newEngine(ctx context.Context, routes *router, resolveURL func(string) stri
 		lastVisitedURL:             &url.URL{},
 		sessionStorage:             sessionStorage,
 		nodes:                      nodeManager{},
-		dispatches:                 make(chan func(), 4096),
-		defers:                     make(chan func(), 4096),
+		scheduler:                  newScheduler(),
+		idleDeadlines:              make(chan time.Time, 1),
 		asynchronousActionHandlers: actionHandlers,
+		lang:                       NewSignal(originPage.Lang()),
 	}
 
-	engine.initBrowser()
 	return engine
 }
 
 func (e *engineX) baseContext() Context {
 	return Context{
 		Context:               e.ctx,
+		request:               e.request,
 		resolveURL:            e.resolveURL,
 		appUpdatable:          e.browser.AppUpdatable,
 		page:                  e.page,
+		routeParams:           e.routeParams,
 		navigate:              e.Navigate,
 		localStorage:          e.localStorage,
 		sessionStorage:        e.sessionStorage,
@@ -93,6 +111,9 @@ func (e *engineX) baseContext() Context {
 		getState:              e.states.Get,
 		setState:              e.states.Set,
 		delState:              e.states.Delete,
+		fetchData:             e.data.Fetch,
+		addStyles:             e.addStyles,
+		lang:                  e.lang,
 
 		notifyComponentEvent: e.nodes.NotifyComponentEvent,
 	}
@@ -103,6 +124,10 @@ func (e *engineX) baseContext() Context {
 // mailto link. If the 'updateHistory' flag is true, the destination is added to
 // the browser's history.
 func (e *engineX) Navigate(destination *url.URL, updateHistory bool) {
+	e.navigate(destination, updateHistory, 0)
+}
+
+func (e *engineX) navigate(destination *url.URL, updateHistory bool, redirects int) {
 	if destination.Host == "" {
 		destination.Host = e.originPage.URL().Host
 	}
@@ -121,6 +146,49 @@ func (e *engineX) Navigate(destination *url.URL, updateHistory bool) {
 		return
 	}
 
+	match, routed := e.routes.match(e.routePath(destination))
+	if e.sameDocument(destination) {
+		e.open(destination, match, routed, updateHistory)
+		return
+	}
+
+	decision := guardNavigation(e.baseContext(), destination, match.guards)
+	if decision.Canceled() {
+		return
+	}
+	if redirect, ok := decision.Redirection(); ok {
+		e.redirect(destination, redirect, updateHistory, redirects)
+		return
+	}
+	e.open(destination, match, routed, updateHistory)
+}
+
+func (e *engineX) redirect(destination *url.URL, redirect string, updateHistory bool, redirects int) {
+	if redirects >= maxRouteRedirects {
+		Log(errors.New("navigation redirected too many times").
+			WithTag("destination", destination).
+			WithTag("redirect", redirect))
+		return
+	}
+
+	u, err := destination.Parse(redirect)
+	if err != nil {
+		Log(errors.New("parsing navigation redirect failed").
+			WithTag("destination", destination).
+			WithTag("redirect", redirect).
+			Wrap(err))
+		return
+	}
+
+	if e.externalNavigation(u) {
+		Window().Get("location").Set("href", u.String())
+		return
+	}
+	e.navigate(u, updateHistory, redirects+1)
+}
+
+// open displays the given route match, without evaluating route guards.
+func (e *engineX) open(destination *url.URL, match routeMatch, routed bool, updateHistory bool) {
 	defer func() {
 		if updateHistory {
 			Window().addHistory(destination)
@@ -136,33 +204,128 @@ func (e *engineX) Navigate(destination *url.URL, updateHistory bool) {
 		}
 	}()
 
-	if destination.Path == e.lastVisitedURL.Path &&
-		destination.Fragment != e.lastVisitedURL.Fragment {
+	if e.sameDocument(destination) {
 		return
 	}
 
+	var page Composer
+	if routed {
+		page = match.newComponent()
+		e.params = match.params
+	} else {
+		page = &notFound{}
+		e.params = nil
+	}
+
+	if err := e.loadWithLayouts(page, match.layouts); err != nil {
+		panic(errors.New("loading component failed").
+			WithTag("component-type", reflect.TypeOf(page)).
+			Wrap(err))
+	}
+}
+
+// sameDocument reports whether the destination only differs from the last
+// visited URL by its fragment.
+func (e *engineX) sameDocument(destination *url.URL) bool {
+	return destination.Path == e.lastVisitedURL.Path &&
+		destination.Fragment != e.lastVisitedURL.Fragment
+}
+
+func (e *engineX) routePath(destination *url.URL) string {
 	path := strings.TrimPrefix(destination.Path, Getenv("GOAPP_ROOT_PREFIX"))
 	if !strings.HasPrefix(path, "/") {
 		path = "/" + path
 	}
-	root, ok := e.routes.createComponent(path)
-	if !ok {
-		root = &notFound{}
+	return path
+}
+
+// loadWithLayouts loads the given page within the given route group layouts.
+// Layouts shared with the previous navigation stay mounted and only the outlet
+// of the deepest shared layout is updated.
+func (e *engineX) loadWithLayouts(page Composer, layouts []*routeLayout) error {
+	shared := 0
+	for shared < len(layouts) &&
+		shared < len(e.layouts) &&
+		layouts[shared] == e.layouts[shared].definition &&
+		e.layouts[shared].layout.Mounted() {
+		shared++
 	}
 
-	if err := e.Load(root); err != nil {
-		panic(errors.New("loading component failed").
-			WithTag("component-type", reflect.TypeOf(root)).
-			Wrap(err))
+	mounted := make([]mountedLayout, len(layouts))
+	copy(mounted, e.layouts[:shared])
+
+	outlet := page
+	for i := len(layouts) - 1; i >= shared; i-- {
+		layout := layouts[i].newLayout()
+		layout.SetOutlet(outlet)
+		mounted[i] = mountedLayout{
+			definition: layouts[i],
+			layout:     layout,
+		}
+		outlet = layout
+	}
+	e.layouts = mounted
+
+	if shared == 0 {
+		return e.Load(outlet)
 	}
+
+	parent := mounted[shared-1].layout
+	parent.SetOutlet(outlet)
+	if _, err := e.nodes.UpdateComponentRoot(e.baseContext(), parent); err != nil {
+		return errors.New("updating layout outlet failed").
+			WithTag("layout-type", reflect.TypeOf(parent)).
+			Wrap(err)
+	}
+	return nil
 }
 
 func (e *engineX) initBrowser() {
 	if IsServer {
 		return
 	}
+
+	// Hosts without a document, such as wasm tests run by Node.js, have no
+	// page to initialize.
+	doc := Window().Get("document")
+	hasDocument := doc.Truthy()
+
+	// Embedded apps leave the language and the navigation of the embedding
+	// page to it.
+	if !e.embedded {
+		if hasDocument {
+			e.initLang()
+		}
+		e.browser.HandleNavigation(e.baseContext())
+	}
 	e.browser.HandleEvents(e.baseContext(), e.notifyComponentEvent)
 	e.states.InitBroadcast(e.baseContext())
+
+	if !hasDocument {
+		return
+	}
+
+	if data := Window().GetElementByID(pageDataID); data.Truthy() {
+		if err := e.data.Decode([]byte(data.Get("textContent").String())); err != nil {
+			Log(errors.New("decoding pre-rendering data failed").Wrap(err))
+		}
+	}
+
+	// Styles of components mounted after a streamed page head was written
+	// are at the start of the body, ahead of the pre-rendered root.
+	styles := doc.Call("querySelectorAll", "body > style[data-goapp-styles]")
+	for i := 0; i < styles.Length(); i++ {
+		doc.Get("head").Call("appendChild", styles.Index(i))
+	}
+}
+
+// initLang sets the language of the page to the one persisted with
+// Context.SetLang or preferred by the browser.
+func (e *engineX) initLang() {
+	page := e.page()
+	lang := browserLang(page.Lang())
+	page.SetLang(lang)
+	e.lang = NewSignal(lang)
 }
 
 func (e *engineX) notifyComponentEvent(event any) {
@@ -198,7 +361,15 @@ func (e *engineX) page() Page {
 	return e.originPage
 }
 
+func (e *engineX) routeParams() RouteParams {
+	return e.params
+}
+
 func (e *engineX) Load(v Composer) error {
+	if e.body == nil && e.hydration {
+		return e.hydrate(v)
+	}
+
 	if e.body == nil {
 		body := Body()
 		body = body.setJSElement(Window().Get("document").Get("body")).(HTMLBody)
@@ -223,9 +394,33 @@ func (e *engineX) Load(v Composer) error {
 	return nil
 }
 
+// hydrate loads the given component by attaching it to the markup
+// pre-rendered on the server, which is expected to be the first element of the
+// document body.
+func (e *engineX) hydrate(v Composer) error {
+	body := Body()
+	body = body.setJSElement(Window().Get("document").Get("body")).(HTMLBody)
+	for action, handler := range e.asynchronousActionHandlers {
+		e.actions.Handle(action, body, true, handler)
+	}
+
+	root, err := e.nodes.Hydrate(e.baseContext(), 1, v, body.JSValue(), body.JSValue().firstElementChild())
+	if err != nil {
+		return errors.New("hydrating root failed").Wrap(err)
+	}
+	root = root.setParent(body)
+	e.body = body.setBody([]UI{root}).(HTMLBody)
+	return nil
+}
+
 // Start initiates the main event loop of the engine at the specified framerate.
 // The loop efficiently manages dispatches, component updates, and deferred
 // actions.
+//
+// Dispatched functions are executed by priority and component updates are
+// processed in frames. Both are bounded by a time budget of a frame duration,
+// beyond which the remaining work is deferred to the next iteration of the
+// loop, leaving room for user input to be handled.
 func (e *engineX) Start(framerate int) {
 	if framerate <= 0 {
 		framerate = 30
@@ -237,21 +432,47 @@ func (e *engineX) Start(framerate int) {
 	frames := time.NewTicker(currentFrameDuration)
 	defer frames.Stop()
 
+	if e.frameBudget <= 0 {
+		e.frameBudget = activeFrameDuration
+	}
+
+	activateFrames := func() {
+		if currentFrameDuration != activeFrameDuration {
+			frames.Reset(activeFrameDuration)
+			currentFrameDuration = activeFrameDuration
+		}
+	}
+
 	e.states.CleanupExpiredPersistedStates(e.baseContext())
 
 	for {
+		// DOM changes made during an iteration are committed at once.
+		if err := flushDOMCommands(); err != nil {
+			Log(err)
+		}
+
 		select {
-		case dispatch := <-e.dispatches:
-			if currentFrameDuration != activeFrameDuration {
-				frames.Reset(activeFrameDuration)
-				currentFrameDuration = activeFrameDuration
+		case <-e.scheduler.Ready():
+			if e.scheduler.Urgent() {
+				activateFrames()
+				e.executeDispatches(time.Now().Add(e.frameBudget))
 			}
-			dispatch()
+			e.requestIdle()
 
 		case <-frames.C:
-			e.processFrame()
-			frames.Reset(iddleFrameDuration)
-			currentFrameDuration = iddleFrameDuration
+			if e.processFrame(time.Now().Add(e.frameBudget)) {
+				frames.Reset(iddleFrameDuration)
+				currentFrameDuration = iddleFrameDuration
+			}
+			e.requestIdle()
+
+		case deadline := <-e.idleDeadlines:
+			e.idleRequested = false
+			e.executeIdleDispatches(deadline)
+			if e.updates.Len() != 0 {
+				activateFrames()
+			}
+			e.requestIdle()
 
 		case <-e.ctx.Done():
 			return
@@ -259,8 +480,84 @@ func (e *engineX) Start(framerate int) {
 	}
 }
 
-func (e *engineX) processFrame() {
+// executeDispatches executes the queued functions by priority, except the
+// ones with IdlePriority, until the queue is empty or the given deadline is
+// exceeded.
+func (e *engineX) executeDispatches(deadline time.Time) {
+	for {
+		dispatch, ok := e.scheduler.Pop(false)
+		if !ok {
+			return
+		}
+		dispatch()
+
+		if time.Now().After(deadline) {
+			// Remaining dispatches are executed on the next loop iteration.
+			e.scheduler.Signal()
+			return
+		}
+	}
+}
+
+// executeIdleDispatches executes the queued functions with IdlePriority until
+// the given deadline is exceeded or more urgent work is queued. At least one
+// function is executed.
+func (e *engineX) executeIdleDispatches(deadline time.Time) {
+	for !e.scheduler.Urgent() {
+		dispatch, ok := e.scheduler.Pop(true)
+		if !ok {
+			return
+		}
+		dispatch()
+
+		if time.Now().After(deadline) {
+			return
+		}
+	}
+}
+
+// requestIdle requests an idle period to execute the queued functions with
+// IdlePriority, when nothing else is pending. Idle periods are reported by
+// requestIdleCallback when the browser supports it.
+func (e *engineX) requestIdle() {
+	if e.idleRequested ||
+		e.scheduler.Urgent() ||
+		e.scheduler.Len() == 0 ||
+		e.updates.Len() != 0 {
+		return
+	}
+	e.idleRequested = true
+
+	if IsClient && Window().Get("requestIdleCallback").Truthy() {
+		if e.idleCallback == nil {
+			e.idleCallback = FuncOf(func(this Value, args []Value) any {
+				remaining := time.Duration(args[0].Call("timeRemaining").Float() * float64(time.Millisecond))
+				select {
+				case e.idleDeadlines <- time.Now().Add(remaining):
+				default:
+				}
+				return nil
+			})
+		}
+		Window().Call("requestIdleCallback", e.idleCallback)
+		return
+	}
+
+	e.idleDeadlines <- time.Now().Add(e.frameBudget)
+}
+
+// processFrame updates the queued components in the order of their depth. It
+// stops when the given deadline is exceeded, leaving the remaining components
+// queued, and reports whether all the queued components were updated. Deferred
+// functions are executed once all the components are updated. A zero deadline
+// means no time budget.
+func (e *engineX) processFrame(deadline time.Time) bool {
+	completed := true
 	e.updates.ForEach(func(c Composer) {
+		if !completed || !deadline.IsZero() && time.Now().After(deadline) {
+			completed = false
+			return
+		}
 		defer e.updates.Done(c)
 
 		if !c.Mounted() {
@@ -268,23 +565,33 @@ func (e *engineX) processFrame() {
 		}
 
 		if _, err := e.nodes.UpdateComponentRoot(e.baseContext(), c); err != nil {
-			panic(errors.New("updating component failed").Wrap(err))
+			if err = e.nodes.CatchError(e.baseContext(), c.parent(), err); err != nil {
+				panic(errors.New("updating component failed").Wrap(err))
+			}
 		}
 	})
+	if !completed {
+		return false
+	}
+
 	e.executeDefers()
 	e.actions.Cleanup()
 	e.states.Cleanup()
+	return true
 }
 
 func (e *engineX) executeDefers() {
-	for {
-		select {
-		case defere := <-e.defers:
-			defere()
+	// Deferred functions run after the DOM is updated.
+	if err := flushDOMCommands(); err != nil {
+		Log(err)
+	}
 
-		default:
+	for {
+		defere, ok := e.defers.Pop()
+		if !ok {
 			return
 		}
+		defere()
 	}
 }
 
@@ -293,9 +600,14 @@ func (e *engineX) executeDefers() {
 // frame.
 func (e *engineX) ConsumeNext() {
 	e.goroutines.Wait()
-	dispatch := <-e.dispatches
-	dispatch()
-	e.processFrame()
+	for {
+		if dispatch, ok := e.scheduler.Pop(true); ok {
+			dispatch()
+			break
+		}
+		<-e.scheduler.Ready()
+	}
+	e.processFrame(time.Time{})
 }
 
 // ConsumeAll continuously waits for ongoing goroutines to finish, executes all
@@ -303,20 +615,33 @@ func (e *engineX) ConsumeNext() {
 // frame.
 func (e *engineX) ConsumeAll() {
 	for {
-		select {
-		case dispatch := <-e.dispatches:
+		if dispatch, ok := e.scheduler.Pop(true); ok {
 			dispatch()
+			continue
+		}
 
-		default:
-			e.processFrame()
-			e.goroutines.Wait()
-			if len(e.dispatches) == 0 {
-				return
-			}
+		e.processFrame(time.Time{})
+		e.goroutines.Wait()
+		if e.scheduler.Len() == 0 {
+			return
 		}
 	}
 }
 
+// ConsumeData waits for the data fetched with Context.FetchData, consuming the
+// dispatches that store it into components as it arrives, until no fetch is
+// pending. It returns an error when the engine context is done before all the
+// data is received.
+func (e *engineX) ConsumeData() error {
+	for e.data.Pending() {
+		if err := e.data.Wait(e.ctx); err != nil {
+			return err
+		}
+		e.ConsumeAll()
+	}
+	return nil
+}
+
 // Encode serializes the given HTML element, integrating the engine's root
 // component as the initial child within the document's body. The final HTML
 // content, including the standard DOCTYPE declaration, is written  to the
@@ -348,12 +673,51 @@ func (e *engineX) Encode(w *bytes.Buffer, document HTMLHtml) error {
 	return nil
 }
 
-func (e *engineX) dispatch(v func()) {
-	e.dispatches <- v
+// EncodeStream serializes the given HTML element like Encode, but writes it in
+// two steps. The document, which is expected to only contain a head, is
+// written first, followed by a flush. Then the body returned by the given
+// function is written with the engine's root component as its first child,
+// only preceded by the leading elements returned along with the body.
+func (e *engineX) EncodeStream(w *bufio.Writer, document HTMLHtml, body func() (HTMLBody, []UI)) error {
+	if e.body == nil {
+		return errors.New("no component loaded")
+	}
+	root := e.body.body()[0]
+	ctx := e.baseContext()
+
+	w.WriteString("<!DOCTYPE html>\n")
+	e.nodes.encodeHTMLOpeningTag(ctx, w, 0, document)
+	w.WriteByte('\n')
+	for _, child := range document.(HTML).body() {
+		e.nodes.encode(ctx, w, 1, child)
+		w.WriteByte('\n')
+	}
+	if err := w.Flush(); err != nil {
+		return errors.New("flushing document head failed").Wrap(err)
+	}
+
+	documentBody, leading := body()
+	children := make([]UI, 0, len(leading)+len(documentBody.body())+1)
+	children = append(children, leading...)
+	children = append(children, root)
+	children = append(children, documentBody.body()...)
+	documentBody.setBody(children)
+
+	e.nodes.encode(ctx, w, 1, documentBody)
+	w.WriteByte('\n')
+	e.nodes.encodeHTMLClosingTag(w, document)
+	if err := w.Flush(); err != nil {
+		return errors.New("flushing document body failed").Wrap(err)
+	}
+	return nil
+}
+
+func (e *engineX) dispatch(p Priority, v func()) {
+	e.scheduler.Push(p, v)
 }
 
 func (e *engineX) defere(v func()) {
-	e.defers <- v
+	e.defers.Push(v)
 }
 
 func (e *engineX) async(v func()) {
@@ -363,3 +727,154 @@ func (e *engineX) async(v func()) {
 		e.goroutines.Done()
 	}()
 }
+
+// addStyles records the scoped styles of the given component type. In the
+// browser, styles are injected into the page head when they are not already
+// there, such as when they were emitted during pre-rendering.
+func (e *engineX) addStyles(v Styler) {
+	s := componentStyleOf(v)
+	if !e.styles.Add(s) || IsServer {
+		return
+	}
+
+	doc := Window().Get("document")
+	if doc.Call("querySelector", `style[data-goapp-styles="`+s.scope+`"]`).Truthy() {
+		return
+	}
+
+	style := doc.Call("createElement", "style")
+	style.Call("setAttribute", "data-goapp-styles", s.scope)
+	style.Set("textContent", s.css)
+	doc.Get("head").Call("appendChild", style)
+}
+
+// defineCustomElements defines the given components registered with
+// RegisterElement as custom elements in the browser.
+func (e *engineX) defineCustomElements(elements map[string]customElement) {
+	tags := make([]string, 0, len(elements))
+	for tag := range elements {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	for _, tag := range tags {
+		element := elements[tag]
+
+		connected := FuncOf(func(this Value, args []Value) any {
+			host := args[0]
+			e.dispatch(NormalPriority, func() {
+				e.mountCustomElement(element, host)
+			})
+			return nil
+		})
+
+		disconnected := FuncOf(func(this Value, args []Value) any {
+			host := args[0]
+			e.dispatch(NormalPriority, func() {
+				e.dismountCustomElement(host)
+			})
+			return nil
+		})
+
+		attributeChanged := FuncOf(func(this Value, args []Value) any {
+			host := args[0]
+			name := args[1].String()
+			present := !args[2].IsNull()
+
+			var value string
+			if present {
+				value = args[2].String()
+			}
+
+			e.dispatch(NormalPriority, func() {
+				e.updateCustomElement(element, host, name, value, present)
+			})
+			return nil
+		})
+
+		Window().Call("goappDefineCustomElement",
+			tag,
+			element.observedAttributes(),
+			connected,
+			disconnected,
+			attributeChanged,
+		)
+	}
+}
+
+// mountCustomElement mounts a new instance of the component registered as the
+// given custom element into the given host element, replacing its content.
+func (e *engineX) mountCustomElement(element customElement, host Value) {
+	if !host.Get("isConnected").Bool() || host.Get(customElementIDProperty).Truthy() {
+		return
+	}
+
+	compo := element.newComponent()
+	for name := range element.attributes {
+		value := host.Call("getAttribute", name)
+		present := !value.IsNull()
+
+		var raw string
+		if present {
+			raw = value.String()
+		}
+		if err := element.setAttribute(compo, name, raw, present); err != nil {
+			Log(err)
+		}
+	}
+
+	parent := Elem(element.tag).setJSElement(host)
+	compo.setParent(parent)
+	mounted, err := e.nodes.Mount(e.baseContext(), 1, compo)
+	if err != nil {
+		Log(errors.New("mounting custom element failed").
+			WithTag("tag", element.tag).
+			Wrap(err))
+		return
+	}
+	mounted = mounted.setParent(parent)
+	parent.setBody([]UI{mounted})
+
+	host.setInnerHTML("")
+	host.appendChild(mounted)
+	host.Set(customElementIDProperty, e.elements.Add(mounted.(Composer)))
+}
+
+// dismountCustomElement dismounts the component mounted into the given host
+// element.
+func (e *engineX) dismountCustomElement(host Value) {
+	id := host.Get(customElementIDProperty)
+	if !id.Truthy() {
+		return
+	}
+
+	compo, ok := e.elements.Get(id.Int())
+	if !ok {
+		return
+	}
+	e.elements.Remove(id.Int())
+	host.Delete(customElementIDProperty)
+
+	host.removeChild(compo)
+	e.nodes.Dismount(compo)
+}
+
+// updateCustomElement sets the component field bound to the given attribute of
+// the given host element, and updates the component.
+func (e *engineX) updateCustomElement(element customElement, host Value, name, value string, present bool) {
+	id := host.Get(customElementIDProperty)
+	if !id.Truthy() {
+		return
+	}
+
+	compo, ok := e.elements.Get(id.Int())
+	if !ok {
+		return
+	}
+
+	if err := element.setAttribute(compo, name, value, present); err != nil {
+		Log(err)
+		return
+	}
+	e.updates.Add(compo)
+}
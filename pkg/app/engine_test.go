@@ -1,11 +1,13 @@
 package app
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -15,6 +17,7 @@ func TestEngineBaseContext(t *testing.T) {
 	ctx := e.baseContext()
 	require.NotNil(t, ctx.Context)
 	require.NotNil(t, ctx.page)
+	require.NotNil(t, ctx.routeParams)
 	require.NotNil(t, ctx.resolveURL)
 	require.NotNil(t, ctx.navigate)
 	require.NotNil(t, ctx.localStorage)
@@ -55,6 +58,17 @@ func TestEngineLoad(t *testing.T) {
 		require.IsType(t, &bar{}, e.body.body()[0])
 	})
 
+	t.Run("load hydrates body", func(t *testing.T) {
+		e := newTestEngine()
+		e.hydration = true
+		e.Load(&hello{})
+		require.IsType(t, &hello{}, e.body.body()[0])
+		require.True(t, e.body.body()[0].Mounted())
+
+		e.Load(&bar{})
+		require.IsType(t, &bar{}, e.body.body()[0])
+	})
+
 	t.Run("load body update with a non mountable component panics", func(t *testing.T) {
 		e := newTestEngine()
 		e.Load(&hello{})
@@ -83,6 +97,20 @@ func TestEngineNavigate(t *testing.T) {
 		require.Equal(t, "/hello", e.lastVisitedURL.Path)
 	})
 
+	t.Run("url with route params is loaded", func(t *testing.T) {
+		e := newTestEngine()
+		e.routes.routeWithPattern("", "/users/{id}", NewZeroComponentFactory(&hello{}))
+
+		destination, _ := url.Parse("/users/42")
+		e.Navigate(destination, false)
+		require.IsType(t, &hello{}, e.body.body()[0])
+		require.Equal(t, RouteParams{"id": "42"}, e.baseContext().RouteParams())
+
+		destination, _ = url.Parse("/unknown")
+		e.Navigate(destination, false)
+		require.Nil(t, e.baseContext().RouteParams())
+	})
+
 	t.Run("mailto is loaded", func(t *testing.T) {
 		e := newTestEngine()
 		destination, _ := url.Parse("mailto:contact@murlok.io")
@@ -233,6 +261,100 @@ func TestEngineStart(t *testing.T) {
 	e.Start(0)
 }
 
+func TestEngineExecuteDispatches(t *testing.T) {
+	t.Run("dispatches are executed by priority", func(t *testing.T) {
+		e := newTestEngine()
+
+		var calls []string
+		e.dispatch(IdlePriority, func() { calls = append(calls, "idle") })
+		e.dispatch(NormalPriority, func() { calls = append(calls, "normal") })
+		e.dispatch(UserInputPriority, func() { calls = append(calls, "input") })
+
+		e.executeDispatches(time.Now().Add(time.Hour))
+		require.Equal(t, []string{"input", "normal"}, calls)
+		require.Equal(t, 1, e.scheduler.Len())
+	})
+
+	t.Run("dispatches beyond the deadline are deferred", func(t *testing.T) {
+		e := newTestEngine()
+
+		var calls int
+		for i := 0; i < 3; i++ {
+			e.dispatch(NormalPriority, func() { calls++ })
+		}
+		<-e.scheduler.Ready()
+
+		e.executeDispatches(time.Now().Add(-time.Second))
+		require.Equal(t, 1, calls)
+		require.Equal(t, 2, e.scheduler.Len())
+		require.Len(t, e.scheduler.Ready(), 1)
+	})
+
+	t.Run("idle dispatches stop when urgent work is queued", func(t *testing.T) {
+		e := newTestEngine()
+
+		var calls []string
+		e.dispatch(IdlePriority, func() {
+			calls = append(calls, "idle-1")
+			e.dispatch(NormalPriority, func() { calls = append(calls, "normal") })
+		})
+		e.dispatch(IdlePriority, func() { calls = append(calls, "idle-2") })
+
+		e.executeIdleDispatches(time.Now().Add(time.Hour))
+		require.Equal(t, []string{"idle-1"}, calls)
+		require.Equal(t, 2, e.scheduler.Len())
+	})
+
+	t.Run("idle period is requested when only idle dispatches are queued", func(t *testing.T) {
+		e := newTestEngine()
+
+		e.requestIdle()
+		require.False(t, e.idleRequested)
+
+		e.dispatch(IdlePriority, func() {})
+		e.requestIdle()
+		require.True(t, e.idleRequested)
+		require.Len(t, e.idleDeadlines, 1)
+
+		e.requestIdle()
+		require.Len(t, e.idleDeadlines, 1)
+	})
+}
+
+func TestEngineProcessFrame(t *testing.T) {
+	t.Run("frame updates all queued components", func(t *testing.T) {
+		e := newTestEngine()
+		e.Load(&hello{})
+		e.ConsumeAll()
+
+		deferred := false
+		e.updates.Add(e.body.body()[0].(Composer))
+		e.defere(func() { deferred = true })
+
+		require.True(t, e.processFrame(time.Now().Add(time.Hour)))
+		require.Zero(t, e.updates.Len())
+		require.True(t, deferred)
+	})
+
+	t.Run("frame exceeding its budget defers updates", func(t *testing.T) {
+		e := newTestEngine()
+		e.Load(&hello{})
+		e.ConsumeAll()
+
+		deferred := false
+		e.updates.Add(e.body.body()[0].(Composer))
+		e.defere(func() { deferred = true })
+
+		require.False(t, e.processFrame(time.Now().Add(-time.Second)))
+		require.Equal(t, 1, e.updates.Len())
+		require.False(t, deferred)
+
+		require.True(t, e.processFrame(time.Time{}))
+		require.Zero(t, e.updates.Len())
+		require.True(t, deferred)
+	})
+}
+
 func TestEngineEncode(t *testing.T) {
 	t.Run("encoding when engine did not load a component returns an error", func(t *testing.T) {
 		e := newTestEngine()
@@ -270,6 +392,54 @@ func TestEngineEncode(t *testing.T) {
 	})
 }
 
+func TestEngineEncodeStream(t *testing.T) {
+	t.Run("encoding when engine did not load a component returns an error", func(t *testing.T) {
+		e := newTestEngine()
+
+		var b bytes.Buffer
+		err := e.EncodeStream(bufio.NewWriter(&b), Html(), func() (HTMLBody, []UI) {
+			return Body(), nil
+		})
+		require.Error(t, err)
+		require.Empty(t, b.Bytes())
+	})
+
+	t.Run("head is flushed before body is requested", func(t *testing.T) {
+		e := newTestEngine()
+		compo := &compoWithCustomRoot{Root: Span()}
+		e.Load(compo)
+
+		var b bytes.Buffer
+		var head string
+		err := e.EncodeStream(bufio.NewWriter(&b), Html().privateBody(
+			Head().Body(
+				Title().Text("hi"),
+			),
+		), func() (HTMLBody, []UI) {
+			head = b.String()
+			return Body().privateBody(
+				Text("bye"),
+			), nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "<!DOCTYPE html>\n<html>\n  <head>\n    <title>hi</title>\n  </head>\n", head)
+		require.Equal(t, head+"  <body>\n    <span></span>\n    bye\n  </body>\n</html>", b.String())
+	})
+
+	t.Run("leading elements are written before the root", func(t *testing.T) {
+		e := newTestEngine()
+		compo := &compoWithCustomRoot{Root: Span()}
+		e.Load(compo)
+
+		var b bytes.Buffer
+		err := e.EncodeStream(bufio.NewWriter(&b), Html(), func() (HTMLBody, []UI) {
+			return Body().privateBody(Text("bye")), []UI{Text("hi")}
+		})
+		require.NoError(t, err)
+		require.Equal(t, "<!DOCTYPE html>\n<html>\n  <body>\n    hi\n    <span></span>\n    bye\n  </body>\n</html>", b.String())
+	})
+}
+
 func newTestEngine() *engineX {
 	return NewTestEngine().(*engineX)
 }
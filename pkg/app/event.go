@@ -25,6 +25,12 @@ func (e Event) StopImmediatePropagation() {
 	e.Call("stopImmediatePropagation")
 }
 
+// Detail returns the data passed when a CustomEvent was initialized, such as
+// the events dispatched by custom elements.
+func (e Event) Detail() Value {
+	return e.Get("detail")
+}
+
 type eventHandlers map[string]eventHandler
 
 func (h eventHandlers) Set(event string, eh EventHandler, scope ...any) {
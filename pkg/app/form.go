@@ -0,0 +1,711 @@
+package app
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+const (
+	// The layout used to convert time.Time form fields when their tag does not
+	// specify one. It matches the value of date inputs.
+	defaultFormTimeLayout = "2006-01-02"
+)
+
+// Validator checks the value of a form field and returns an error that
+// describes why the value is invalid. The error message is meant to be
+// displayed to the user.
+type Validator func(v any) error
+
+// AsyncValidator checks the value of a form field like a Validator, from a
+// separate goroutine. It is meant for checks that require I/O, such as
+// verifying that a username is available.
+type AsyncValidator func(ctx context.Context, v any) error
+
+// Required returns a validator that reports the given message when the value
+// of a field is the zero value of its type.
+func Required(msg string) Validator {
+	return func(v any) error {
+		if v == nil || reflect.ValueOf(v).IsZero() {
+			return fmt.Errorf("%s", msg)
+		}
+		return nil
+	}
+}
+
+// FormValueKind is the kind of value expected by a form field. It is given to
+// the function set with FormBinder.OnConversionError when the value entered in
+// a field can't be converted to the type of the field.
+type FormValueKind string
+
+const (
+	// FormText is the kind of the fields whose type implements
+	// encoding.TextUnmarshaler.
+	FormText FormValueKind = "text"
+
+	// FormBool is the kind of boolean fields.
+	FormBool FormValueKind = "bool"
+
+	// FormNumber is the kind of integer and float fields.
+	FormNumber FormValueKind = "number"
+
+	// FormDate is the kind of time.Time fields.
+	FormDate FormValueKind = "date"
+
+	// FormUnsupported is the kind of the fields whose type is not supported.
+	FormUnsupported FormValueKind = "unsupported"
+)
+
+// The error type of the form values that can't be converted to the type of
+// their field. Errors of this type have "field" and "kind" tags.
+const formConversionErrorType = "app.formConversionError"
+
+// FormBinder binds the fields of a struct to form elements. It converts the
+// values entered by the user to the type of their field, validates them, and
+// tracks the touched, dirty and submitting states of the form.
+//
+// Struct fields are bound by the name set in their "form" tag. Supported field
+// types are strings, booleans, integers, floats, time.Time and types that
+// implement encoding.TextMarshaler and encoding.TextUnmarshaler, such as
+// enums. The layout of time.Time fields is specified with the "layout" tag
+// option and defaults to the one of date inputs:
+//
+//	type signup struct {
+//	    Name     string    `form:"name"`
+//	    Age      int       `form:"age"`
+//	    Birthday time.Time `form:"birthday,layout=2006-01-02"`
+//	    Plan     Plan      `form:"plan"`
+//	    Terms    bool      `form:"terms"`
+//	}
+//
+// A FormBinder is stored in an unexported field of the component that renders
+// the form, and must only be used from the UI goroutine:
+//
+//	func (c *signupForm) OnInit() {
+//	    c.form = app.BindForm(signup{}).
+//	        Validate("name", app.Required("Name is required.")).
+//	        OnSubmit(c.signup)
+//	}
+//
+//	func (c *signupForm) Render() app.UI {
+//	    return app.Form().
+//	        OnSubmit(c.form.Submit).
+//	        Body(
+//	            c.form.Input("name"),
+//	            app.If(c.form.Touched("name") && c.form.Error("name") != nil, func() app.UI {
+//	                return app.Text(c.form.Error("name"))
+//	            }),
+//	            app.Button().
+//	                Type("submit").
+//	                Disabled(c.form.Submitting()).
+//	                Text("Sign up"),
+//	        )
+//	}
+type FormBinder[T any] struct {
+	id            string
+	value         T
+	fields        map[string]*formField
+	names         []string
+	submitHandler func(Context, T) error
+	conversionErr func(Context, string, FormValueKind) error
+	submitAction  string
+	submitTags    []Tagger
+	submitPending bool
+	submitting    bool
+	submitErr     error
+}
+
+type formField struct {
+	index           []int
+	layout          string
+	validators      []Validator
+	asyncValidators []AsyncValidator
+	initialRaw      string
+	raw             string
+	conversionErr   error
+	err             error
+	touched         bool
+	validating      bool
+	validation      int
+}
+
+// BindForm creates a form binder for the fields of the given struct value.
+func BindForm[T any](v T) *FormBinder[T] {
+	f := &FormBinder[T]{
+		fields: make(map[string]*formField),
+	}
+	f.id = fmt.Sprintf("form-%p", f)
+
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Struct {
+		Log(errors.New("binding form failed").
+			WithTag("type", t).
+			Wrap(errors.New("value is not a struct")))
+		return f
+	}
+
+	for _, sf := range reflect.VisibleFields(t) {
+		if !sf.IsExported() || sf.Anonymous {
+			continue
+		}
+
+		name, options, _ := strings.Cut(sf.Tag.Get("form"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		layout := defaultFormTimeLayout
+		if l, ok := strings.CutPrefix(options, "layout="); ok {
+			layout = l
+		}
+
+		f.fields[name] = &formField{
+			index:  sf.Index,
+			layout: layout,
+		}
+		f.names = append(f.names, name)
+	}
+
+	f.Reset(v)
+	return f
+}
+
+// Validate adds validators to the field with the given name. Validators are
+// called in order each time the field value changes and before the form is
+// submitted, and stop at the first error.
+func (f *FormBinder[T]) Validate(field string, v ...Validator) *FormBinder[T] {
+	if fd := f.field(field); fd != nil {
+		fd.validators = append(fd.validators, v...)
+	}
+	return f
+}
+
+// ValidateAsync adds asynchronous validators to the field with the given
+// name. They are called after the validators added with Validate succeed, and
+// the result of a validation is discarded when the field value changed in the
+// meantime.
+func (f *FormBinder[T]) ValidateAsync(field string, v ...AsyncValidator) *FormBinder[T] {
+	if fd := f.field(field); fd != nil {
+		fd.asyncValidators = append(fd.asyncValidators, v...)
+	}
+	return f
+}
+
+// OnSubmit sets the handler called with the form value when the form is
+// submitted and valid. The handler is called in a separate goroutine, and the
+// form is submitting until it returns. The returned error is reported by
+// SubmitError.
+func (f *FormBinder[T]) OnSubmit(h func(Context, T) error) *FormBinder[T] {
+	f.submitHandler = h
+	return f
+}
+
+// OnConversionError sets the function that returns the error reported by Error
+// when the value entered in a field can't be converted to the type of the
+// field. It is called with the field name and the kind of value the field
+// expects. By default, the error message describes the expected kind of value
+// and is translated with Context.T.
+func (f *FormBinder[T]) OnConversionError(h func(ctx Context, field string, kind FormValueKind) error) *FormBinder[T] {
+	f.conversionErr = h
+	return f
+}
+
+// SubmitAction sets the action created with the form value when the form is
+// submitted and valid.
+func (f *FormBinder[T]) SubmitAction(action string, tags ...Tagger) *FormBinder[T] {
+	f.submitAction = action
+	f.submitTags = tags
+	return f
+}
+
+// Value returns the form value.
+func (f *FormBinder[T]) Value() T {
+	return f.value
+}
+
+// Reset sets the form value and clears the touched, dirty and error states of
+// its fields.
+func (f *FormBinder[T]) Reset(v T) {
+	f.value = v
+	f.submitPending = false
+	f.submitErr = nil
+
+	for _, fd := range f.fields {
+		raw := formatFormValue(f.fieldValue(fd), fd.layout)
+		fd.initialRaw = raw
+		fd.raw = raw
+		fd.conversionErr = nil
+		fd.err = nil
+		fd.touched = false
+		fd.validating = false
+		fd.validation++
+	}
+}
+
+// Input returns an input element bound to the field with the given name.
+func (f *FormBinder[T]) Input(field string) HTMLInput {
+	input := Input().Name(field)
+
+	fd := f.field(field)
+	if fd == nil {
+		return input
+	}
+
+	return input.
+		Value(fd.raw).
+		OnInput(func(ctx Context, e Event) {
+			f.change(ctx, field, ctx.JSSrc().Get("value").String())
+		}, f.id, field).
+		OnBlur(func(ctx Context, e Event) {
+			f.touch(field)
+		}, f.id, field)
+}
+
+// Checkbox returns a checkbox input element bound to the boolean field with
+// the given name.
+func (f *FormBinder[T]) Checkbox(field string) HTMLInput {
+	input := Input().
+		Type("checkbox").
+		Name(field)
+
+	fd := f.field(field)
+	if fd == nil {
+		return input
+	}
+
+	checked, _ := strconv.ParseBool(fd.raw)
+	return input.
+		Checked(checked).
+		OnChange(func(ctx Context, e Event) {
+			f.change(ctx, field, strconv.FormatBool(ctx.JSSrc().Get("checked").Bool()))
+			f.touch(field)
+		}, f.id, field)
+}
+
+// Textarea returns a textarea element bound to the field with the given name.
+func (f *FormBinder[T]) Textarea(field string) HTMLTextarea {
+	textarea := Textarea().Name(field)
+
+	fd := f.field(field)
+	if fd == nil {
+		return textarea
+	}
+
+	return textarea.
+		Text(fd.raw).
+		OnInput(func(ctx Context, e Event) {
+			f.change(ctx, field, ctx.JSSrc().Get("value").String())
+		}, f.id, field).
+		OnBlur(func(ctx Context, e Event) {
+			f.touch(field)
+		}, f.id, field)
+}
+
+// Select returns a select element bound to the field with the given name,
+// with the given options. The option whose value matches the field value is
+// selected.
+func (f *FormBinder[T]) Select(field string, options ...HTMLOption) HTMLSelect {
+	sel := Select().Name(field)
+
+	fd := f.field(field)
+	if fd == nil {
+		return sel.Body(optionsToUI(options)...)
+	}
+
+	for _, o := range options {
+		o.Selected(o.attrs()["value"] == fd.raw)
+	}
+
+	return sel.
+		OnChange(func(ctx Context, e Event) {
+			f.change(ctx, field, ctx.JSSrc().Get("value").String())
+			f.touch(field)
+		}, f.id, field).
+		Body(optionsToUI(options)...)
+}
+
+// Submit is the event handler to set on the submit event of the form element.
+// It marks all the fields as touched and validates them. When the form is
+// valid, the form value is passed to the handler set with OnSubmit and to the
+// action set with SubmitAction.
+func (f *FormBinder[T]) Submit(ctx Context, e Event) {
+	e.PreventDefault()
+
+	if f.submitting {
+		return
+	}
+
+	for _, name := range f.names {
+		f.touch(name)
+		f.validate(ctx, name)
+	}
+	f.submitPending = true
+	f.submit(ctx)
+}
+
+// Touched reports whether the field with the given name lost focus or the form
+// was submitted.
+func (f *FormBinder[T]) Touched(field string) bool {
+	if fd, ok := f.fields[field]; ok {
+		return fd.touched
+	}
+	return false
+}
+
+// Dirty reports whether the value of the field with the given name differs
+// from its initial one.
+func (f *FormBinder[T]) Dirty(field string) bool {
+	if fd, ok := f.fields[field]; ok {
+		return fd.raw != fd.initialRaw
+	}
+	return false
+}
+
+// IsDirty reports whether the value of a field differs from its initial one.
+func (f *FormBinder[T]) IsDirty() bool {
+	for _, name := range f.names {
+		if f.Dirty(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Error returns the validation error of the field with the given name, or nil
+// when its value is valid.
+func (f *FormBinder[T]) Error(field string) error {
+	if fd, ok := f.fields[field]; ok {
+		return fd.err
+	}
+	return nil
+}
+
+// Validating reports whether the value of the field with the given name is
+// being checked by asynchronous validators.
+func (f *FormBinder[T]) Validating(field string) bool {
+	if fd, ok := f.fields[field]; ok {
+		return fd.validating
+	}
+	return false
+}
+
+// Valid reports whether all the fields are valid and no asynchronous
+// validation is pending.
+func (f *FormBinder[T]) Valid() bool {
+	for _, fd := range f.fields {
+		if fd.err != nil || fd.validating {
+			return false
+		}
+	}
+	return true
+}
+
+// Submitting reports whether the form is being submitted.
+func (f *FormBinder[T]) Submitting() bool {
+	return f.submitting || f.submitPending
+}
+
+// SubmitError returns the error returned by the handler set with OnSubmit
+// during the last submission.
+func (f *FormBinder[T]) SubmitError() error {
+	return f.submitErr
+}
+
+func (f *FormBinder[T]) field(name string) *formField {
+	fd, ok := f.fields[name]
+	if !ok {
+		Log(errors.New("binding form field failed").
+			WithTag("field", name).
+			WithTag("type", reflect.TypeOf(f.value)).
+			Wrap(errors.New("field not found")))
+		return nil
+	}
+	return fd
+}
+
+func (f *FormBinder[T]) fieldValue(fd *formField) reflect.Value {
+	return reflect.ValueOf(&f.value).Elem().FieldByIndex(fd.index)
+}
+
+// change stores the raw value entered in the given field, converts it to the
+// field type and validates it.
+func (f *FormBinder[T]) change(ctx Context, name, raw string) {
+	fd, ok := f.fields[name]
+	if !ok {
+		return
+	}
+
+	fd.raw = raw
+	fd.conversionErr = nil
+	if err := parseFormValue(name, raw, f.fieldValue(fd), fd.layout); err != nil {
+		fd.conversionErr = f.conversionError(ctx, err)
+	}
+	f.validate(ctx, name)
+}
+
+// conversionError returns the error reported for the given conversion error
+// returned by parseFormValue.
+func (f *FormBinder[T]) conversionError(ctx Context, err error) error {
+	field, _ := errors.Tag(err, "field").(string)
+	kind, _ := errors.Tag(err, "kind").(FormValueKind)
+
+	h := f.conversionErr
+	if h == nil {
+		h = defaultFormConversionError
+	}
+	return h(ctx, field, kind)
+}
+
+func defaultFormConversionError(ctx Context, field string, kind FormValueKind) error {
+	switch kind {
+	case FormNumber:
+		return fmt.Errorf("%s", ctx.T("invalid number"))
+
+	case FormDate:
+		return fmt.Errorf("%s", ctx.T("invalid date"))
+
+	case FormUnsupported:
+		return fmt.Errorf("%s", ctx.T("unsupported value"))
+
+	default:
+		return fmt.Errorf("%s", ctx.T("invalid value"))
+	}
+}
+
+func (f *FormBinder[T]) touch(name string) {
+	if fd, ok := f.fields[name]; ok {
+		fd.touched = true
+	}
+}
+
+func (f *FormBinder[T]) validate(ctx Context, name string) {
+	fd := f.fields[name]
+	fd.validation++
+	fd.validating = false
+
+	fd.err = fd.conversionErr
+	value := f.fieldValue(fd).Interface()
+	for _, validate := range fd.validators {
+		if fd.err != nil {
+			break
+		}
+		fd.err = validate(value)
+	}
+	if fd.err != nil || len(fd.asyncValidators) == 0 {
+		return
+	}
+
+	fd.validating = true
+	validation := fd.validation
+	validators := fd.asyncValidators
+	ctx.Async(func() {
+		var err error
+		for _, validate := range validators {
+			if err = validate(ctx, value); err != nil {
+				break
+			}
+		}
+
+		ctx.Dispatch(func(ctx Context) {
+			if fd.validation != validation {
+				return
+			}
+			fd.validating = false
+			fd.err = err
+			f.submit(ctx)
+		})
+	})
+}
+
+// submit passes the form value to the submit handler and action when a
+// submission is pending and all the fields are validated.
+func (f *FormBinder[T]) submit(ctx Context) {
+	if !f.submitPending {
+		return
+	}
+	for _, fd := range f.fields {
+		if fd.validating {
+			return
+		}
+	}
+	f.submitPending = false
+
+	if !f.Valid() {
+		return
+	}
+
+	value := f.value
+	raws := make(map[*formField]string, len(f.fields))
+	for _, fd := range f.fields {
+		raws[fd] = fd.raw
+	}
+	submitted := func() {
+		for fd, raw := range raws {
+			fd.initialRaw = raw
+		}
+	}
+
+	if f.submitAction != "" {
+		ctx.NewActionWithValue(f.submitAction, value, f.submitTags...)
+	}
+
+	if f.submitHandler == nil {
+		submitted()
+		return
+	}
+	f.submitting = true
+	f.submitErr = nil
+	handler := f.submitHandler
+	ctx.Async(func() {
+		err := handler(ctx, value)
+		ctx.Dispatch(func(ctx Context) {
+			f.submitting = false
+			f.submitErr = err
+			if err == nil {
+				submitted()
+			}
+		})
+	})
+}
+
+func optionsToUI(options []HTMLOption) []UI {
+	elems := make([]UI, len(options))
+	for i, o := range options {
+		elems[i] = o
+	}
+	return elems
+}
+
+// parseFormValue converts the given raw value and stores it into the value of
+// the given field. Empty raw values are stored as the zero value of the field.
+// Conversion errors have the formConversionErrorType type and are tagged with
+// the field name and the kind of value it expects.
+func parseFormValue(field, raw string, v reflect.Value, layout string) error {
+	if raw == "" {
+		v.SetZero()
+		return nil
+	}
+
+	switch v.Interface().(type) {
+	case time.Time:
+		t, err := time.Parse(layout, raw)
+		if err != nil {
+			return formConversionError(field, FormDate, err)
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText([]byte(raw)); err != nil {
+			return formConversionError(field, FormText, err)
+		}
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return formConversionError(field, FormBool, err)
+		}
+		v.SetBool(b)
+
+	case reflect.Int,
+		reflect.Int8,
+		reflect.Int16,
+		reflect.Int32,
+		reflect.Int64:
+		i, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return formConversionError(field, FormNumber, err)
+		}
+		v.SetInt(i)
+
+	case reflect.Uint,
+		reflect.Uint8,
+		reflect.Uint16,
+		reflect.Uint32,
+		reflect.Uint64:
+		i, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return formConversionError(field, FormNumber, err)
+		}
+		v.SetUint(i)
+
+	case reflect.Float32,
+		reflect.Float64:
+		n, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return formConversionError(field, FormNumber, err)
+		}
+		v.SetFloat(n)
+
+	default:
+		return formConversionError(field, FormUnsupported, errors.New("unsupported field type").
+			WithTag("type", v.Type()))
+	}
+	return nil
+}
+
+func formConversionError(field string, kind FormValueKind, err error) error {
+	return errors.New("converting form value failed").
+		WithType(formConversionErrorType).
+		WithTag("field", field).
+		WithTag("kind", kind).
+		Wrap(err)
+}
+
+// formatFormValue returns the raw representation of the given field value.
+func formatFormValue(v reflect.Value, layout string) string {
+	switch t := v.Interface().(type) {
+	case time.Time:
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(layout)
+	}
+
+	if m, ok := v.Addr().Interface().(encoding.TextMarshaler); ok {
+		b, _ := m.MarshalText()
+		return string(b)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+
+	case reflect.Int,
+		reflect.Int8,
+		reflect.Int16,
+		reflect.Int32,
+		reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+
+	case reflect.Uint,
+		reflect.Uint8,
+		reflect.Uint16,
+		reflect.Uint32,
+		reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+
+	case reflect.Float32,
+		reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+
+	default:
+		return ""
+	}
+}
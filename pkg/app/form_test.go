@@ -0,0 +1,446 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type formPlan int
+
+const (
+	formFreePlan formPlan = iota
+	formProPlan
+)
+
+func (p formPlan) MarshalText() ([]byte, error) {
+	switch p {
+	case formProPlan:
+		return []byte("pro"), nil
+
+	default:
+		return []byte("free"), nil
+	}
+}
+
+func (p *formPlan) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "free":
+		*p = formFreePlan
+
+	case "pro":
+		*p = formProPlan
+
+	default:
+		return fmt.Errorf("unknown plan")
+	}
+	return nil
+}
+
+type formSignup struct {
+	Name     string    `form:"name"`
+	Age      int       `form:"age"`
+	Birthday time.Time `form:"birthday"`
+	Meeting  time.Time `form:"meeting,layout=2006-01-02T15:04"`
+	Plan     formPlan  `form:"plan"`
+	Terms    bool      `form:"terms"`
+	Ignored  string
+}
+
+func TestBindForm(t *testing.T) {
+	t.Run("fields are formatted", func(t *testing.T) {
+		f := BindForm(formSignup{
+			Name:     "Maxence",
+			Age:      42,
+			Birthday: time.Date(1986, 2, 14, 0, 0, 0, 0, time.UTC),
+			Plan:     formProPlan,
+			Terms:    true,
+		})
+		require.Len(t, f.fields, 6)
+		require.Equal(t, "Maxence", f.fields["name"].raw)
+		require.Equal(t, "42", f.fields["age"].raw)
+		require.Equal(t, "1986-02-14", f.fields["birthday"].raw)
+		require.Equal(t, "", f.fields["meeting"].raw)
+		require.Equal(t, "2006-01-02T15:04", f.fields["meeting"].layout)
+		require.Equal(t, "pro", f.fields["plan"].raw)
+		require.Equal(t, "true", f.fields["terms"].raw)
+		require.False(t, f.IsDirty())
+		require.True(t, f.Valid())
+	})
+
+	t.Run("binding non struct value is logged", func(t *testing.T) {
+		var logs int
+		defer func(logger func(string, ...any)) {
+			DefaultLogger = logger
+		}(DefaultLogger)
+		DefaultLogger = func(string, ...any) {
+			logs++
+		}
+
+		f := BindForm(42)
+		require.Equal(t, 1, logs)
+		require.Empty(t, f.fields)
+	})
+}
+
+func TestFormBinderChange(t *testing.T) {
+	ctx := makeTestContext()
+
+	t.Run("values are converted", func(t *testing.T) {
+		f := BindForm(formSignup{})
+		f.change(ctx, "name", "Maxence")
+		f.change(ctx, "age", "42")
+		f.change(ctx, "birthday", "1986-02-14")
+		f.change(ctx, "meeting", "2024-05-01T10:30")
+		f.change(ctx, "plan", "pro")
+		f.change(ctx, "terms", "true")
+
+		require.Equal(t, formSignup{
+			Name:     "Maxence",
+			Age:      42,
+			Birthday: time.Date(1986, 2, 14, 0, 0, 0, 0, time.UTC),
+			Meeting:  time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC),
+			Plan:     formProPlan,
+			Terms:    true,
+		}, f.Value())
+		require.True(t, f.Valid())
+	})
+
+	t.Run("conversion error is reported", func(t *testing.T) {
+		f := BindForm(formSignup{Age: 21, Plan: formProPlan})
+
+		f.change(ctx, "age", "42a")
+		require.EqualError(t, f.Error("age"), "invalid number")
+		require.Equal(t, 21, f.Value().Age)
+		require.Equal(t, "42a", f.fields["age"].raw)
+
+		f.change(ctx, "plan", "enterprise")
+		require.EqualError(t, f.Error("plan"), "invalid value")
+		require.False(t, f.Valid())
+
+		f.change(ctx, "age", "")
+		require.NoError(t, f.Error("age"))
+		require.Zero(t, f.Value().Age)
+	})
+
+	t.Run("conversion error is mapped", func(t *testing.T) {
+		var field string
+		var kind FormValueKind
+		f := BindForm(formSignup{}).
+			OnConversionError(func(ctx Context, f string, k FormValueKind) error {
+				field = f
+				kind = k
+				return fmt.Errorf("date attendue")
+			})
+
+		f.change(ctx, "birthday", "14/02/1986")
+		require.EqualError(t, f.Error("birthday"), "date attendue")
+		require.Equal(t, "birthday", field)
+		require.Equal(t, FormDate, kind)
+	})
+
+	t.Run("dirty state is tracked", func(t *testing.T) {
+		f := BindForm(formSignup{Name: "Maxence"})
+		require.False(t, f.Dirty("name"))
+
+		f.change(ctx, "name", "Max")
+		require.True(t, f.Dirty("name"))
+		require.True(t, f.IsDirty())
+
+		f.change(ctx, "name", "Maxence")
+		require.False(t, f.Dirty("name"))
+		require.False(t, f.IsDirty())
+	})
+
+	t.Run("reset clears states", func(t *testing.T) {
+		f := BindForm(formSignup{}).Validate("name", Required("name is required"))
+		f.change(ctx, "name", "")
+		f.touch("name")
+		require.Error(t, f.Error("name"))
+		require.True(t, f.Touched("name"))
+
+		f.Reset(formSignup{Name: "Maxence"})
+		require.NoError(t, f.Error("name"))
+		require.False(t, f.Touched("name"))
+		require.False(t, f.Dirty("name"))
+		require.Equal(t, "Maxence", f.Value().Name)
+	})
+}
+
+func TestFormBinderValidate(t *testing.T) {
+	var m nodeManager
+	div, err := m.Mount(makeTestContext(), 1, Div())
+	require.NoError(t, err)
+
+	t.Run("validators are called in order", func(t *testing.T) {
+		var calls int
+		f := BindForm(formSignup{}).
+			Validate("name",
+				Required("name is required"),
+				func(v any) error {
+					calls++
+					return nil
+				},
+			)
+		ctx := m.context(makeTestContext(), div)
+
+		f.change(ctx, "name", "")
+		require.EqualError(t, f.Error("name"), "name is required")
+		require.Zero(t, calls)
+
+		f.change(ctx, "name", "Maxence")
+		require.NoError(t, f.Error("name"))
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("async validator result is set", func(t *testing.T) {
+		var async []func()
+		ctx := m.context(makeTestContext(), div)
+		ctx.async = func(f func()) {
+			async = append(async, f)
+		}
+
+		f := BindForm(formSignup{}).
+			ValidateAsync("name", func(ctx context.Context, v any) error {
+				if v == "taken" {
+					return fmt.Errorf("name is taken")
+				}
+				return nil
+			})
+
+		f.change(ctx, "name", "taken")
+		require.True(t, f.Validating("name"))
+		require.False(t, f.Valid())
+		require.Len(t, async, 1)
+
+		async[0]()
+		require.False(t, f.Validating("name"))
+		require.EqualError(t, f.Error("name"), "name is taken")
+	})
+
+	t.Run("outdated async validator result is discarded", func(t *testing.T) {
+		var async []func()
+		ctx := m.context(makeTestContext(), div)
+		ctx.async = func(f func()) {
+			async = append(async, f)
+		}
+
+		f := BindForm(formSignup{}).
+			ValidateAsync("name", func(ctx context.Context, v any) error {
+				if v == "taken" {
+					return fmt.Errorf("name is taken")
+				}
+				return nil
+			})
+
+		f.change(ctx, "name", "taken")
+		f.change(ctx, "name", "available")
+		require.Len(t, async, 2)
+
+		async[1]()
+		require.False(t, f.Validating("name"))
+		require.NoError(t, f.Error("name"))
+
+		async[0]()
+		require.NoError(t, f.Error("name"))
+	})
+
+	t.Run("async validator is not called when sync validator fails", func(t *testing.T) {
+		var calls int
+		ctx := m.context(makeTestContext(), div)
+
+		f := BindForm(formSignup{}).
+			Validate("name", Required("name is required")).
+			ValidateAsync("name", func(ctx context.Context, v any) error {
+				calls++
+				return nil
+			})
+
+		f.change(ctx, "name", "")
+		require.Error(t, f.Error("name"))
+		require.False(t, f.Validating("name"))
+		require.Zero(t, calls)
+	})
+}
+
+func TestFormBinderSubmit(t *testing.T) {
+	var m nodeManager
+	div, err := m.Mount(makeTestContext(), 1, Div())
+	require.NoError(t, err)
+
+	t.Run("valid form is submitted", func(t *testing.T) {
+		var async []func()
+		ctx := m.context(makeTestContext(), div)
+		ctx.async = func(f func()) {
+			async = append(async, f)
+		}
+
+		var submitted formSignup
+		f := BindForm(formSignup{}).
+			Validate("name", Required("name is required")).
+			OnSubmit(func(ctx Context, v formSignup) error {
+				submitted = v
+				return fmt.Errorf("server error")
+			})
+		f.change(ctx, "name", "Maxence")
+		require.True(t, f.IsDirty())
+
+		f.Submit(ctx, Event{Value: Null()})
+		require.True(t, f.Touched("name"))
+		require.True(t, f.Touched("age"))
+		require.True(t, f.Submitting())
+		require.True(t, f.IsDirty())
+		require.Len(t, async, 1)
+
+		async[0]()
+		require.False(t, f.Submitting())
+		require.Equal(t, "Maxence", submitted.Name)
+		require.EqualError(t, f.SubmitError(), "server error")
+		require.True(t, f.IsDirty())
+	})
+
+	t.Run("successful submit clears the dirty state", func(t *testing.T) {
+		var async []func()
+		ctx := m.context(makeTestContext(), div)
+		ctx.async = func(f func()) {
+			async = append(async, f)
+		}
+
+		f := BindForm(formSignup{}).
+			OnSubmit(func(ctx Context, v formSignup) error {
+				return nil
+			})
+		f.change(ctx, "name", "Maxence")
+		f.Submit(ctx, Event{Value: Null()})
+		require.True(t, f.IsDirty())
+
+		f.change(ctx, "age", "42")
+		async[0]()
+		require.NoError(t, f.SubmitError())
+		require.False(t, f.Dirty("name"))
+		require.True(t, f.Dirty("age"))
+	})
+
+	t.Run("invalid form is not submitted", func(t *testing.T) {
+		ctx := m.context(makeTestContext(), div)
+
+		var submitted bool
+		f := BindForm(formSignup{}).
+			Validate("name", Required("name is required")).
+			OnSubmit(func(ctx Context, v formSignup) error {
+				submitted = true
+				return nil
+			})
+
+		f.Submit(ctx, Event{Value: Null()})
+		require.False(t, submitted)
+		require.False(t, f.Submitting())
+		require.True(t, f.Touched("name"))
+		require.Error(t, f.Error("name"))
+	})
+
+	t.Run("form is submitted after async validation", func(t *testing.T) {
+		var async []func()
+		ctx := m.context(makeTestContext(), div)
+		ctx.async = func(f func()) {
+			async = append(async, f)
+		}
+
+		var submitted bool
+		f := BindForm(formSignup{Name: "Maxence"}).
+			ValidateAsync("name", func(ctx context.Context, v any) error {
+				return nil
+			}).
+			OnSubmit(func(ctx Context, v formSignup) error {
+				submitted = true
+				return nil
+			})
+
+		f.Submit(ctx, Event{Value: Null()})
+		require.True(t, f.Submitting())
+		require.Len(t, async, 1)
+
+		async[0]()
+		require.Len(t, async, 2)
+		require.False(t, submitted)
+
+		async[1]()
+		require.True(t, submitted)
+		require.False(t, f.Submitting())
+		require.NoError(t, f.SubmitError())
+	})
+
+	t.Run("submit action is created", func(t *testing.T) {
+		var action Action
+		ctx := m.context(makeTestContext(), div)
+		ctx.postAction = func(ctx Context, a Action) {
+			action = a
+		}
+
+		f := BindForm(formSignup{Name: "Maxence"}).
+			SubmitAction("signup", T("source", "test"))
+
+		f.Submit(ctx, Event{Value: Null()})
+		require.Equal(t, "signup", action.Name)
+		require.Equal(t, formSignup{Name: "Maxence"}, action.Value)
+		require.Equal(t, "test", action.Tags.Get("source"))
+		require.False(t, f.Submitting())
+	})
+}
+
+func TestFormBinderElements(t *testing.T) {
+	f := BindForm(formSignup{
+		Name:  "Maxence",
+		Plan:  formProPlan,
+		Terms: true,
+	})
+
+	t.Run("input", func(t *testing.T) {
+		input := f.Input("name")
+		require.Equal(t, "name", input.attrs()["name"])
+		require.Equal(t, "Maxence", input.attrs()["value"])
+		require.Contains(t, input.events(), "input")
+		require.Contains(t, input.events(), "blur")
+	})
+
+	t.Run("checkbox", func(t *testing.T) {
+		checkbox := f.Checkbox("terms")
+		require.Equal(t, "checkbox", checkbox.attrs()["type"])
+		require.Equal(t, "true", checkbox.attrs()["checked"])
+		require.Contains(t, checkbox.events(), "change")
+	})
+
+	t.Run("textarea", func(t *testing.T) {
+		textarea := f.Textarea("name")
+		require.Equal(t, "Maxence", textarea.attrs()["value"])
+		require.Contains(t, textarea.events(), "input")
+	})
+
+	t.Run("select", func(t *testing.T) {
+		sel := f.Select("plan",
+			Option().Value("free").Text("Free"),
+			Option().Value("pro").Text("Pro"),
+		)
+		require.Len(t, sel.body(), 2)
+		require.Equal(t, "false", sel.body()[0].(HTML).attrs()["selected"])
+		require.Equal(t, "true", sel.body()[1].(HTML).attrs()["selected"])
+		require.Contains(t, sel.events(), "change")
+	})
+
+	t.Run("binding unknown field is logged", func(t *testing.T) {
+		var logs int
+		defer func(logger func(string, ...any)) {
+			DefaultLogger = logger
+		}(DefaultLogger)
+		DefaultLogger = func(string, ...any) {
+			logs++
+		}
+
+		input := f.Input("unknown")
+		require.Equal(t, 1, logs)
+		require.Empty(t, input.events())
+	})
+}
@@ -163,14 +163,19 @@ function goappNewNotification(jsonNotification) {
 // -----------------------------------------------------------------------------
 function goappKeepBodyClean() {
   const body = document.body;
-  const bodyChildrenCount = body.children.length;
+  const bodyChildren = () =>
+    Array.from(body.children).filter(
+      (child) => !child.hasAttribute("data-goapp-portal")
+    );
+  const bodyChildrenCount = bodyChildren().length;
 
   const mutationObserver = new MutationObserver(function (mutationList) {
     mutationList.forEach((mutation) => {
       switch (mutation.type) {
         case "childList":
-          while (body.children.length > bodyChildrenCount) {
-            body.removeChild(body.lastChild);
+          const children = bodyChildren();
+          while (children.length > bodyChildrenCount) {
+            body.removeChild(children.pop());
           }
           break;
       }
@@ -184,6 +189,261 @@ function goappKeepBodyClean() {
   return () => mutationObserver.disconnect();
 }
 
+// -----------------------------------------------------------------------------
+// DOM Commands
+// -----------------------------------------------------------------------------
+const goappDOMNodes = new Map();
+
+// The codes of the DOM operations indexed by name, and their number of
+// arguments indexed by code. Both are generated from the domOps table in
+// dom.go.
+const goappDOMOps = {{.DOMOps}};
+const goappDOMCommandArgs = {{.DOMCommandArgs}};
+
+function goappDOMNode(id) {
+  return goappDOMNodes.get(id);
+}
+
+function goappApplyDOMCommands(commands, ...values) {
+  commands = JSON.parse(commands);
+
+  const ref = (v) => (v < 0 ? values[-v - 1] : goappDOMNodes.get(v));
+  const errors = [];
+
+  for (let i = 0; i < commands.length; ) {
+    const op = commands[i++];
+    const args = commands.slice(i, i + goappDOMCommandArgs[op]);
+    i += goappDOMCommandArgs[op];
+
+    try {
+      switch (op) {
+        case goappDOMOps.createElement:
+          goappDOMNodes.set(
+            args[0],
+            args[2]
+              ? document.createElementNS(args[2], args[1])
+              : document.createElement(args[1])
+          );
+          break;
+
+        case goappDOMOps.createTextNode:
+          goappDOMNodes.set(args[0], document.createTextNode(args[1]));
+          break;
+
+        case goappDOMOps.setAttr:
+          ref(args[0]).setAttribute(args[1], args[2]);
+          break;
+
+        case goappDOMOps.delAttr:
+          ref(args[0]).removeAttribute(args[1]);
+          break;
+
+        case goappDOMOps.setProperty:
+          ref(args[0])[args[1]] = args[2];
+          break;
+
+        case goappDOMOps.appendChild:
+          ref(args[0]).appendChild(ref(args[1]));
+          break;
+
+        case goappDOMOps.insertBefore:
+          ref(args[0]).insertBefore(ref(args[1]), ref(args[2]));
+          break;
+
+        case goappDOMOps.replaceChild:
+          ref(args[0]).replaceChild(ref(args[1]), ref(args[2]));
+          break;
+
+        case goappDOMOps.removeChild:
+          ref(args[0]).removeChild(ref(args[1]));
+          break;
+
+        case goappDOMOps.setNodeValue:
+          ref(args[0]).nodeValue = args[1];
+          break;
+
+        case goappDOMOps.setInnerHTML:
+          ref(args[0]).innerHTML = args[1];
+          break;
+
+        case goappDOMOps.setInnerText:
+          ref(args[0]).innerText = args[1];
+          break;
+
+        case goappDOMOps.addEventListener:
+          ref(args[0]).addEventListener(args[1], ref(args[2]));
+          break;
+
+        case goappDOMOps.removeEventListener:
+          ref(args[0]).removeEventListener(args[1], ref(args[2]));
+          break;
+
+        case goappDOMOps.releaseNode:
+          goappDOMNodes.delete(args[0]);
+          break;
+
+        case goappDOMOps.transitionEnter:
+          goappTransitionEnter(ref(args[0]), args[1]);
+          break;
+
+        case goappDOMOps.transitionLeave:
+          goappTransitionLeave(ref(args[0]), ref(args[1]), args[2]);
+          break;
+
+        case goappDOMOps.transitionMove:
+          goappTransitionMove(ref(args[0]), args[1]);
+          break;
+      }
+    } catch (err) {
+      const name = Object.keys(goappDOMOps).find((k) => goappDOMOps[k] === op);
+      errors.push(`${name} ${JSON.stringify(args)}: ${err}`);
+    }
+  }
+  return errors;
+}
+
+// -----------------------------------------------------------------------------
+// Transitions
+// -----------------------------------------------------------------------------
+const goappTransitionMoves = new Map();
+
+function goappTransitionEnter(node, name) {
+  node.classList.add(`${name}-enter-from`, `${name}-enter-active`);
+
+  goappNextFrame(() => {
+    node.classList.remove(`${name}-enter-from`);
+    node.classList.add(`${name}-enter-to`);
+
+    goappWhenAnimationsFinished(node, () => {
+      node.classList.remove(`${name}-enter-active`, `${name}-enter-to`);
+    });
+  });
+}
+
+function goappTransitionLeave(parent, node, name) {
+  node.classList.remove(
+    `${name}-enter-from`,
+    `${name}-enter-active`,
+    `${name}-enter-to`
+  );
+  node.classList.add(`${name}-leave-from`, `${name}-leave-active`);
+
+  goappNextFrame(() => {
+    node.classList.remove(`${name}-leave-from`);
+    node.classList.add(`${name}-leave-to`);
+
+    goappWhenAnimationsFinished(node, () => {
+      if (node.parentNode === parent) {
+        parent.removeChild(node);
+      }
+    });
+  });
+}
+
+function goappTransitionMove(node, name) {
+  if (goappTransitionMoves.has(node)) {
+    return;
+  }
+  if (!goappTransitionMoves.size) {
+    queueMicrotask(goappApplyTransitionMoves);
+  }
+
+  goappTransitionMoves.set(node, {
+    name: name,
+    rect: node.getBoundingClientRect(),
+  });
+}
+
+function goappApplyTransitionMoves() {
+  const moves = [];
+
+  for (const [node, move] of goappTransitionMoves) {
+    const rect = node.getBoundingClientRect();
+    const dx = move.rect.left - rect.left;
+    const dy = move.rect.top - rect.top;
+    if (!dx && !dy) {
+      continue;
+    }
+
+    move.transform = node.style.transform;
+    node.style.transform = `translate(${dx}px, ${dy}px) ${move.transform}`;
+    node.style.transitionDuration = "0s";
+    moves.push([node, move]);
+  }
+  goappTransitionMoves.clear();
+
+  if (!moves.length) {
+    return;
+  }
+
+  // Forces a reflow so the moved nodes start from their previous position.
+  document.body.offsetHeight;
+
+  for (const [node, move] of moves) {
+    node.classList.add(`${move.name}-move`);
+    node.style.transform = move.transform;
+    node.style.transitionDuration = "";
+
+    goappWhenAnimationsFinished(node, () => {
+      node.classList.remove(`${move.name}-move`);
+    });
+  }
+}
+
+function goappNextFrame(fn) {
+  requestAnimationFrame(() => {
+    requestAnimationFrame(fn);
+  });
+}
+
+function goappWhenAnimationsFinished(node, fn) {
+  const animations = node.getAnimations ? node.getAnimations() : [];
+  if (!animations.length) {
+    fn();
+    return;
+  }
+
+  Promise.allSettled(animations.map((a) => a.finished)).then(fn);
+}
+
+// -----------------------------------------------------------------------------
+// Custom Elements
+// -----------------------------------------------------------------------------
+function goappDefineCustomElement(
+  tag,
+  observedAttributes,
+  connected,
+  disconnected,
+  attributeChanged
+) {
+  if (customElements.get(tag)) {
+    return;
+  }
+
+  customElements.define(
+    tag,
+    class extends HTMLElement {
+      static get observedAttributes() {
+        return observedAttributes;
+      }
+
+      connectedCallback() {
+        connected(this);
+      }
+
+      disconnectedCallback() {
+        disconnected(this);
+      }
+
+      attributeChangedCallback(name, oldValue, newValue) {
+        if (oldValue !== newValue) {
+          attributeChanged(this, name, newValue);
+        }
+      }
+    }
+  );
+}
+
 // -----------------------------------------------------------------------------
 // Web Assembly
 // -----------------------------------------------------------------------------
@@ -191,7 +451,7 @@ async function goappInitWebAssembly() {
   const loader = document.getElementById("app-wasm-loader");
 
   if (!goappCanLoadWebAssembly()) {
-    loader.remove();
+    loader?.remove();
     return;
   }
 
@@ -208,6 +468,9 @@ async function goappInitWebAssembly() {
 
   try {
     const showProgress = (progress) => {
+      if (!loaderLabel) {
+        return;
+      }
       loaderLabel.innerText = goappLoadingLabel.replace("{progress}", progress);
     };
     showProgress(0);
@@ -219,10 +482,14 @@ async function goappInitWebAssembly() {
     );
 
     go.run(wasm.instance);
-    loader.remove();
+    loader?.remove();
   } catch (err) {
-    loaderIcon.className = "goapp-logo";
-    loaderLabel.innerText = err;
+    if (loaderIcon) {
+      loaderIcon.className = "goapp-logo";
+    }
+    if (loaderLabel) {
+      loaderLabel.innerText = err;
+    }
     console.error("loading wasm failed: ", err);
   }
 }
@@ -13,12 +13,34 @@ import (
 
 type tag struct {
 	Name          string
+	TagOverride   string
+	Namespace     string
 	Type          tagType
 	Doc           string
 	Attrs         []attr
 	EventHandlers []eventHandler
 }
 
+func (t tag) tagName() string {
+	if t.TagOverride != "" {
+		return t.TagOverride
+	}
+	return strings.ToLower(t.Name)
+}
+
+func (t tag) kind() string {
+	switch t.Namespace {
+	case "svgNamespace":
+		return "SVG"
+
+	case "mathMLNamespace":
+		return "MathML"
+
+	default:
+		return "HTML"
+	}
+}
+
 type tagType int
 
 const (
@@ -310,6 +332,7 @@ var tags = []tag{
 		Name: "Elem",
 		Doc:  "that is customizable.",
 		Attrs: withGlobalAttrs(attrsByNames(
+			"property*",
 			"xmlns",
 		)...),
 		EventHandlers: withGlobalEventHandlers(),
@@ -319,6 +342,7 @@ var tags = []tag{
 		Type: selfClosing,
 		Doc:  "that is self-closing and customizable.",
 		Attrs: withGlobalAttrs(attrsByNames(
+			"property*",
 			"xmlns",
 		)...),
 		EventHandlers: withGlobalEventHandlers(),
@@ -1030,6 +1054,413 @@ var tags = []tag{
 	},
 }
 
+// The SVG elements. Their constructors are named after their tag, except the
+// ones that conflict with HTML elements or existing functions, which are
+// prefixed with Svg.
+var svgTags = []tag{
+	{
+		Name:          "Circle",
+		Doc:           "that draws a circle based on a center point and a radius.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("cx", "cy", "r", "pathlength")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "ClipPath",
+		TagOverride:   "clipPath",
+		Doc:           "that defines a clipping path, restricting the region to which paint can be applied.",
+		Attrs:         withSVGGlobalAttrs(attrsByNames("clippathunits", "transform")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Defs",
+		Doc:           "that stores graphical objects to be used at a later time, such as gradients or symbols.",
+		Attrs:         withSVGGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Desc",
+		Doc:           "that provides an accessible, long-text description of an SVG element.",
+		Attrs:         withSVGGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Ellipse",
+		Doc:           "that draws an ellipse based on a center point and two radii.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("cx", "cy", "rx", "ry", "pathlength")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "ForeignObject",
+		TagOverride:   "foreignObject",
+		Doc:           "that includes elements from a different XML namespace, such as HTML, into an SVG drawing.",
+		Attrs:         withSVGGlobalAttrs(attrsByNames("x", "y", "svg-width", "svg-height")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "G",
+		Doc:           "that groups other SVG elements, applying its attributes to all of them.",
+		Attrs:         withSVGPresentationAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Line",
+		Doc:           "that draws a straight line connecting two points.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("x1", "y1", "x2", "y2", "pathlength", "marker-start", "marker-end")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:        "LinearGradient",
+		TagOverride: "linearGradient",
+		Doc:         "that defines a linear gradient used to fill or stroke graphical elements.",
+		Attrs: withSVGGlobalAttrs(attrsByNames(
+			"x1",
+			"y1",
+			"x2",
+			"y2",
+			"gradientunits",
+			"gradienttransform",
+			"spreadmethod",
+			"href",
+		)...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name: "Marker",
+		Doc:  "that defines a graphic drawn at the vertices of a path, line, polyline or polygon.",
+		Attrs: withSVGGlobalAttrs(attrsByNames(
+			"viewbox",
+			"preserveaspectratio",
+			"refx",
+			"refy",
+			"markerwidth",
+			"markerheight",
+			"markerunits",
+			"orient",
+		)...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name: "Mask",
+		Doc:  "that defines an alpha mask for compositing the current object into the background.",
+		Attrs: withSVGGlobalAttrs(attrsByNames(
+			"x",
+			"y",
+			"svg-width",
+			"svg-height",
+			"maskunits",
+			"maskcontentunits",
+		)...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Path",
+		Doc:           "that draws a shape defined by a series of path commands.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("d", "pathlength", "marker-start", "marker-mid", "marker-end")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name: "Pattern",
+		Doc:  "that defines a graphic that is tiled to fill or stroke an object.",
+		Attrs: withSVGGlobalAttrs(attrsByNames(
+			"x",
+			"y",
+			"svg-width",
+			"svg-height",
+			"viewbox",
+			"preserveaspectratio",
+			"patternunits",
+			"patterncontentunits",
+			"patterntransform",
+			"href",
+		)...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Polygon",
+		Doc:           "that draws a closed shape made of straight lines connecting a set of points.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("points", "pathlength", "marker-start", "marker-mid", "marker-end")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Polyline",
+		Doc:           "that draws an open shape made of straight lines connecting a set of points.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("points", "pathlength", "marker-start", "marker-mid", "marker-end")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:        "RadialGradient",
+		TagOverride: "radialGradient",
+		Doc:         "that defines a radial gradient used to fill or stroke graphical elements.",
+		Attrs: withSVGGlobalAttrs(attrsByNames(
+			"cx",
+			"cy",
+			"r",
+			"fx",
+			"fy",
+			"gradientunits",
+			"gradienttransform",
+			"spreadmethod",
+			"href",
+		)...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Rect",
+		Doc:           "that draws a rectangle, optionally with rounded corners.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("x", "y", "svg-width", "svg-height", "rx", "ry", "pathlength")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Stop",
+		Doc:           "that defines a color and its position within a gradient.",
+		Attrs:         withSVGGlobalAttrs(attrsByNames("offset", "stop-color", "stop-opacity")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Svg",
+		Doc:           "that creates a container for SVG graphics, defining a new coordinate system and viewport.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("viewbox", "preserveaspectratio", "x", "y", "svg-width", "svg-height")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "SvgA",
+		TagOverride:   "a",
+		Doc:           "that creates a hyperlink within an SVG drawing.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("href", "target")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "SvgImage",
+		TagOverride:   "image",
+		Doc:           "that includes an image, such as a PNG or another SVG file, within an SVG drawing.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("href", "x", "y", "svg-width", "svg-height", "preserveaspectratio")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:        "SvgText",
+		TagOverride: "text",
+		Doc:         "that draws a graphical text within an SVG drawing.",
+		Attrs: withSVGPresentationAttrs(attrsByNames(
+			"x",
+			"y",
+			"dx",
+			"dy",
+			"text-anchor",
+			"dominant-baseline",
+			"font-size",
+			"font-weight",
+		)...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "SvgTitle",
+		TagOverride:   "title",
+		Doc:           "that provides an accessible, short-text description of an SVG element, usually displayed as a tooltip.",
+		Attrs:         withSVGGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Symbol",
+		Doc:           "that defines a graphical template object which can be instantiated with a use element.",
+		Attrs:         withSVGGlobalAttrs(attrsByNames("viewbox", "preserveaspectratio", "refx", "refy")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name: "Tspan",
+		Doc:  "that defines a subtext within a text element, with its own position and styles.",
+		Attrs: withSVGPresentationAttrs(attrsByNames(
+			"x",
+			"y",
+			"dx",
+			"dy",
+			"text-anchor",
+			"dominant-baseline",
+			"font-size",
+			"font-weight",
+		)...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Use",
+		Doc:           "that duplicates an SVG element referenced by its id, such as a symbol.",
+		Attrs:         withSVGPresentationAttrs(attrsByNames("href", "x", "y", "svg-width", "svg-height")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+}
+
+// The MathML elements.
+var mathMLTags = []tag{
+	{
+		Name:          "Annotation",
+		Doc:           "that contains an alternative representation of a mathematical expression, such as its TeX source.",
+		Attrs:         withMathMLGlobalAttrs(attrsByNames("encoding")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Math",
+		Doc:           "that creates the top-level container of a mathematical expression.",
+		Attrs:         withMathMLGlobalAttrs(attrsByNames("math-display")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mfrac",
+		Doc:           "that displays a fraction, with a numerator and a denominator.",
+		Attrs:         withMathMLGlobalAttrs(attrsByNames("linethickness")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mi",
+		Doc:           "that represents an identifier, such as a variable or a function name.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mn",
+		Doc:           "that represents a numeric literal.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name: "Mo",
+		Doc:  "that represents an operator, such as a parenthesis, a separator or an arithmetic sign.",
+		Attrs: withMathMLGlobalAttrs(attrsByNames(
+			"fence",
+			"largeop",
+			"lspace",
+			"rspace",
+			"stretchy",
+			"symmetric",
+		)...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mover",
+		Doc:           "that displays an accent or a limit over an expression.",
+		Attrs:         withMathMLGlobalAttrs(attrsByNames("accent")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mroot",
+		Doc:           "that displays a root with an explicit index.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mrow",
+		Doc:           "that groups sub-expressions horizontally.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Ms",
+		Doc:           "that represents a string literal.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mspace",
+		Doc:           "that displays a blank space with the given dimensions.",
+		Attrs:         withMathMLGlobalAttrs(attrsByNames("svg-width", "svg-height", "depth")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Msqrt",
+		Doc:           "that displays a square root.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mstyle",
+		Doc:           "that changes the style of its children.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Msub",
+		Doc:           "that attaches a subscript to an expression.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Msubsup",
+		Doc:           "that attaches both a subscript and a superscript to an expression.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Msup",
+		Doc:           "that attaches a superscript to an expression.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mtable",
+		Doc:           "that creates a table or a matrix.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mtd",
+		Doc:           "that represents a cell in a table or a matrix.",
+		Attrs:         withMathMLGlobalAttrs(attrsByNames("columnspan", "rowspan")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mtext",
+		Doc:           "that represents an arbitrary text with no notational meaning.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Mtr",
+		Doc:           "that represents a row in a table or a matrix.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Munder",
+		Doc:           "that displays an accent or a limit under an expression.",
+		Attrs:         withMathMLGlobalAttrs(attrsByNames("accentunder")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Munderover",
+		Doc:           "that displays accents or limits both under and over an expression.",
+		Attrs:         withMathMLGlobalAttrs(attrsByNames("accent", "accentunder")...),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+	{
+		Name:          "Semantics",
+		Doc:           "that associates annotations, such as alternative representations, with a mathematical expression.",
+		Attrs:         withMathMLGlobalAttrs(),
+		EventHandlers: withGlobalEventHandlers(),
+	},
+}
+
+func init() {
+	for i := range svgTags {
+		svgTags[i].Namespace = "svgNamespace"
+	}
+	for i := range mathMLTags {
+		mathMLTags[i].Namespace = "mathMLNamespace"
+	}
+}
+
+func allTags() []tag {
+	res := make([]tag, 0, len(tags)+len(svgTags)+len(mathMLTags))
+	res = append(res, tags...)
+	res = append(res, svgTags...)
+	res = append(res, mathMLTags...)
+	return res
+}
+
+func article(kind string) string {
+	if kind == "HTML" {
+		return "an HTML"
+	}
+	return "a " + kind
+}
+
 type attr struct {
 	Name         string
 	NameOverride string
@@ -1341,6 +1772,11 @@ var attrs = map[string]attr{
 	},
 
 	// K:
+	"key": {
+		Name: "Key",
+		Type: "key",
+		Doc:  "Identifies the element among its siblings. Keyed elements are matched by key rather than by position when their parent is updated, so they are moved instead of being recreated.",
+	},
 	"kind": {
 		Name: "Kind",
 		Type: "fmt",
@@ -1473,6 +1909,11 @@ var attrs = map[string]attr{
 	},
 
 	// R:
+	"property*": {
+		Name: "Prop",
+		Type: "prop|value",
+		Doc:  "Sets a JavaScript property of the element, such as the complex data of a custom element, which is not limited to string values. Values that are not strings, numbers, booleans, maps, slices or JavaScript values are converted through their JSON representation.",
+	},
 	"readonly": {
 		Name: "ReadOnly",
 		Type: "bool",
@@ -1545,6 +1986,11 @@ var attrs = map[string]attr{
 		Type: "fmt",
 		Doc:  "Specifies sizes of icons and images for different page or screen scenarios. Uses the given format and values.",
 	},
+	"slot": {
+		Name: "Slot",
+		Type: "string",
+		Doc:  "Assigns the element to the named slot of the shadow root of its parent, such as a slot of a custom element.",
+	},
 	"span": {
 		Name: "Span",
 		Type: "int",
@@ -1593,7 +2039,7 @@ var attrs = map[string]attr{
 	"styles": {
 		Name: "Styles",
 		Type: "style|map",
-		Doc:  "Allocates multiple CSS styles to an element. Accepts multiple styling definitions.",
+		Doc:  "Allocates multiple CSS styles to an element. Accepts multiple styling definitions, such as the ones built with the css package.",
 	},
 
 	// T:
@@ -1612,6 +2058,11 @@ var attrs = map[string]attr{
 		Type: "fmt",
 		Doc:  "Provides additional information about an element, typically displayed as a tooltip. Can be called with the desired title format and content.",
 	},
+	"transition": {
+		Name: "Transition",
+		Type: "transition",
+		Doc:  "Animates the element with the CSS classes prefixed by the given name when it is mounted, moved among keyed siblings or dismounted. Its removal from the DOM is delayed until its leave transitions and animations are finished.",
+	},
 	"type": {
 		Name: "Type",
 		Type: "fmt",
@@ -1648,6 +2099,454 @@ var attrs = map[string]attr{
 		Type: "xmlns",
 		Doc:  "Defines the XML namespace for the element.",
 	},
+
+	// SVG:
+	"clip-path": {
+		Name:         "ClipPath",
+		NameOverride: "clip-path",
+		Type:         "string",
+		Doc:          "References the clipping path applied to the element, such as \"url(#clip)\".",
+	},
+	"clip-rule": {
+		Name:         "ClipRule",
+		NameOverride: "clip-rule",
+		Type:         "string",
+		Doc:          "Determines how the inside of a clipping shape is computed: nonzero or evenodd.",
+	},
+	"clippathunits": {
+		Name:         "ClipPathUnits",
+		NameOverride: "clipPathUnits",
+		Type:         "string",
+		Doc:          "Defines the coordinate system of the content of a clipping path: userSpaceOnUse or objectBoundingBox.",
+	},
+	"cx": {
+		Name: "Cx",
+		Type: "any",
+		Doc:  "Sets the x-axis coordinate of the center of the shape.",
+	},
+	"cy": {
+		Name: "Cy",
+		Type: "any",
+		Doc:  "Sets the y-axis coordinate of the center of the shape.",
+	},
+	"d": {
+		Name: "D",
+		Type: "string",
+		Doc:  "Defines the path to be drawn, as a series of path commands.",
+	},
+	"dominant-baseline": {
+		Name:         "DominantBaseline",
+		NameOverride: "dominant-baseline",
+		Type:         "string",
+		Doc:          "Specifies the baseline used to align the text vertically, such as middle or hanging.",
+	},
+	"dx": {
+		Name: "Dx",
+		Type: "any",
+		Doc:  "Shifts the text along the x-axis.",
+	},
+	"dy": {
+		Name: "Dy",
+		Type: "any",
+		Doc:  "Shifts the text along the y-axis.",
+	},
+	"fill": {
+		Name: "Fill",
+		Type: "string",
+		Doc:  "Sets the color, gradient or pattern used to paint the interior of the element.",
+	},
+	"fill-opacity": {
+		Name:         "FillOpacity",
+		NameOverride: "fill-opacity",
+		Type:         "any",
+		Doc:          "Sets the opacity of the paint applied to the interior of the element, between 0 and 1.",
+	},
+	"fill-rule": {
+		Name:         "FillRule",
+		NameOverride: "fill-rule",
+		Type:         "string",
+		Doc:          "Determines how the inside of a shape is computed: nonzero or evenodd.",
+	},
+	"font-size": {
+		Name:         "FontSize",
+		NameOverride: "font-size",
+		Type:         "any",
+		Doc:          "Sets the size of the font used to draw the text.",
+	},
+	"font-weight": {
+		Name:         "FontWeight",
+		NameOverride: "font-weight",
+		Type:         "any",
+		Doc:          "Sets the weight of the font used to draw the text.",
+	},
+	"fx": {
+		Name: "Fx",
+		Type: "any",
+		Doc:  "Sets the x-axis coordinate of the focal point of a radial gradient.",
+	},
+	"fy": {
+		Name: "Fy",
+		Type: "any",
+		Doc:  "Sets the y-axis coordinate of the focal point of a radial gradient.",
+	},
+	"gradienttransform": {
+		Name:         "GradientTransform",
+		NameOverride: "gradientTransform",
+		Type:         "string",
+		Doc:          "Applies additional transformations to the gradient coordinate system.",
+	},
+	"gradientunits": {
+		Name:         "GradientUnits",
+		NameOverride: "gradientUnits",
+		Type:         "string",
+		Doc:          "Defines the coordinate system of the gradient attributes: userSpaceOnUse or objectBoundingBox.",
+	},
+	"marker-end": {
+		Name:         "MarkerEnd",
+		NameOverride: "marker-end",
+		Type:         "string",
+		Doc:          "References the marker drawn at the last vertex of the shape, such as \"url(#arrow)\".",
+	},
+	"marker-mid": {
+		Name:         "MarkerMid",
+		NameOverride: "marker-mid",
+		Type:         "string",
+		Doc:          "References the marker drawn at every vertex of the shape except the first and last ones.",
+	},
+	"marker-start": {
+		Name:         "MarkerStart",
+		NameOverride: "marker-start",
+		Type:         "string",
+		Doc:          "References the marker drawn at the first vertex of the shape.",
+	},
+	"markerheight": {
+		Name:         "MarkerHeight",
+		NameOverride: "markerHeight",
+		Type:         "any",
+		Doc:          "Sets the height of the viewport into which the marker is fitted.",
+	},
+	"markerunits": {
+		Name:         "MarkerUnits",
+		NameOverride: "markerUnits",
+		Type:         "string",
+		Doc:          "Defines the coordinate system of the marker dimensions: strokeWidth or userSpaceOnUse.",
+	},
+	"markerwidth": {
+		Name:         "MarkerWidth",
+		NameOverride: "markerWidth",
+		Type:         "any",
+		Doc:          "Sets the width of the viewport into which the marker is fitted.",
+	},
+	"mask": {
+		Name: "Mask",
+		Type: "string",
+		Doc:  "References the mask applied to the element, such as \"url(#mask)\".",
+	},
+	"maskcontentunits": {
+		Name:         "MaskContentUnits",
+		NameOverride: "maskContentUnits",
+		Type:         "string",
+		Doc:          "Defines the coordinate system of the content of a mask: userSpaceOnUse or objectBoundingBox.",
+	},
+	"maskunits": {
+		Name:         "MaskUnits",
+		NameOverride: "maskUnits",
+		Type:         "string",
+		Doc:          "Defines the coordinate system of the mask position and size: userSpaceOnUse or objectBoundingBox.",
+	},
+	"offset": {
+		Name: "Offset",
+		Type: "any",
+		Doc:  "Sets where the gradient stop is placed along the gradient vector, such as 0.5 or \"50%\".",
+	},
+	"opacity": {
+		Name: "Opacity",
+		Type: "any",
+		Doc:  "Sets the opacity of the element, between 0 and 1.",
+	},
+	"orient": {
+		Name: "Orient",
+		Type: "any",
+		Doc:  "Sets the rotation of the marker, in degrees or auto.",
+	},
+	"pathlength": {
+		Name:         "PathLength",
+		NameOverride: "pathLength",
+		Type:         "any",
+		Doc:          "Sets the total length of the path, in user units, used to scale distance computations such as dash arrays.",
+	},
+	"patterncontentunits": {
+		Name:         "PatternContentUnits",
+		NameOverride: "patternContentUnits",
+		Type:         "string",
+		Doc:          "Defines the coordinate system of the content of a pattern: userSpaceOnUse or objectBoundingBox.",
+	},
+	"patterntransform": {
+		Name:         "PatternTransform",
+		NameOverride: "patternTransform",
+		Type:         "string",
+		Doc:          "Applies additional transformations to the pattern coordinate system.",
+	},
+	"patternunits": {
+		Name:         "PatternUnits",
+		NameOverride: "patternUnits",
+		Type:         "string",
+		Doc:          "Defines the coordinate system of the pattern position and size: userSpaceOnUse or objectBoundingBox.",
+	},
+	"points": {
+		Name: "Points",
+		Type: "string",
+		Doc:  "Defines the list of points of the shape, such as \"0,0 10,10 20,0\".",
+	},
+	"preserveaspectratio": {
+		Name:         "PreserveAspectRatio",
+		NameOverride: "preserveAspectRatio",
+		Type:         "string",
+		Doc:          "Determines how the element is scaled to fit its viewport, such as \"xMidYMid meet\".",
+	},
+	"r": {
+		Name: "R",
+		Type: "any",
+		Doc:  "Sets the radius of the shape.",
+	},
+	"refx": {
+		Name:         "RefX",
+		NameOverride: "refX",
+		Type:         "any",
+		Doc:          "Sets the x-axis coordinate of the reference point of the element.",
+	},
+	"refy": {
+		Name:         "RefY",
+		NameOverride: "refY",
+		Type:         "any",
+		Doc:          "Sets the y-axis coordinate of the reference point of the element.",
+	},
+	"rx": {
+		Name: "Rx",
+		Type: "any",
+		Doc:  "Sets the horizontal radius of the shape or of its rounded corners.",
+	},
+	"ry": {
+		Name: "Ry",
+		Type: "any",
+		Doc:  "Sets the vertical radius of the shape or of its rounded corners.",
+	},
+	"spreadmethod": {
+		Name:         "SpreadMethod",
+		NameOverride: "spreadMethod",
+		Type:         "string",
+		Doc:          "Determines how the gradient is painted outside of its bounds: pad, reflect or repeat.",
+	},
+	"stop-color": {
+		Name:         "StopColor",
+		NameOverride: "stop-color",
+		Type:         "string",
+		Doc:          "Sets the color of the gradient stop.",
+	},
+	"stop-opacity": {
+		Name:         "StopOpacity",
+		NameOverride: "stop-opacity",
+		Type:         "any",
+		Doc:          "Sets the opacity of the gradient stop, between 0 and 1.",
+	},
+	"stroke": {
+		Name: "Stroke",
+		Type: "string",
+		Doc:  "Sets the color, gradient or pattern used to paint the outline of the element.",
+	},
+	"stroke-dasharray": {
+		Name:         "StrokeDashArray",
+		NameOverride: "stroke-dasharray",
+		Type:         "string",
+		Doc:          "Defines the pattern of dashes and gaps used to paint the outline, such as \"4 2\".",
+	},
+	"stroke-dashoffset": {
+		Name:         "StrokeDashOffset",
+		NameOverride: "stroke-dashoffset",
+		Type:         "any",
+		Doc:          "Sets the offset of the dash pattern used to paint the outline.",
+	},
+	"stroke-linecap": {
+		Name:         "StrokeLineCap",
+		NameOverride: "stroke-linecap",
+		Type:         "string",
+		Doc:          "Sets the shape drawn at the end of open subpaths: butt, round or square.",
+	},
+	"stroke-linejoin": {
+		Name:         "StrokeLineJoin",
+		NameOverride: "stroke-linejoin",
+		Type:         "string",
+		Doc:          "Sets the shape drawn at the corners of paths: arcs, bevel, miter, miter-clip or round.",
+	},
+	"stroke-opacity": {
+		Name:         "StrokeOpacity",
+		NameOverride: "stroke-opacity",
+		Type:         "any",
+		Doc:          "Sets the opacity of the paint applied to the outline of the element, between 0 and 1.",
+	},
+	"stroke-width": {
+		Name:         "StrokeWidth",
+		NameOverride: "stroke-width",
+		Type:         "any",
+		Doc:          "Sets the width of the outline of the element.",
+	},
+	"svg-height": {
+		Name:         "Height",
+		NameOverride: "height",
+		Type:         "any",
+		Doc:          "Sets the height of the element, in user units or with a unit such as \"100%\".",
+	},
+	"svg-width": {
+		Name:         "Width",
+		NameOverride: "width",
+		Type:         "any",
+		Doc:          "Sets the width of the element, in user units or with a unit such as \"100%\".",
+	},
+	"text-anchor": {
+		Name:         "TextAnchor",
+		NameOverride: "text-anchor",
+		Type:         "string",
+		Doc:          "Aligns the text relative to its position: start, middle or end.",
+	},
+	"transform": {
+		Name: "Transform",
+		Type: "string",
+		Doc:  "Applies transformations to the element, such as \"translate(10 10) rotate(45)\".",
+	},
+	"vector-effect": {
+		Name:         "VectorEffect",
+		NameOverride: "vector-effect",
+		Type:         "string",
+		Doc:          "Specifies the vector effect to use when drawing the element, such as non-scaling-stroke.",
+	},
+	"viewbox": {
+		Name:         "ViewBox",
+		NameOverride: "viewBox",
+		Type:         "string",
+		Doc:          "Defines the position and dimension of the viewport in user units, such as \"0 0 24 24\".",
+	},
+	"x": {
+		Name: "X",
+		Type: "any",
+		Doc:  "Sets the x-axis coordinate of the element.",
+	},
+	"x1": {
+		Name: "X1",
+		Type: "any",
+		Doc:  "Sets the x-axis coordinate of the start point.",
+	},
+	"x2": {
+		Name: "X2",
+		Type: "any",
+		Doc:  "Sets the x-axis coordinate of the end point.",
+	},
+	"y": {
+		Name: "Y",
+		Type: "any",
+		Doc:  "Sets the y-axis coordinate of the element.",
+	},
+	"y1": {
+		Name: "Y1",
+		Type: "any",
+		Doc:  "Sets the y-axis coordinate of the start point.",
+	},
+	"y2": {
+		Name: "Y2",
+		Type: "any",
+		Doc:  "Sets the y-axis coordinate of the end point.",
+	},
+
+	// MathML:
+	"accent": {
+		Name: "Accent",
+		Type: "bool|force",
+		Doc:  "Specifies whether the over script is an accent, drawn closer to the base expression.",
+	},
+	"accentunder": {
+		Name:         "AccentUnder",
+		NameOverride: "accentunder",
+		Type:         "bool|force",
+		Doc:          "Specifies whether the under script is an accent, drawn closer to the base expression.",
+	},
+	"columnspan": {
+		Name:         "ColumnSpan",
+		NameOverride: "columnspan",
+		Type:         "int",
+		Doc:          "Specifies the number of columns that the table cell spans.",
+	},
+	"depth": {
+		Name: "Depth",
+		Type: "string",
+		Doc:  "Sets the depth of the space below the baseline, such as \"1em\".",
+	},
+	"displaystyle": {
+		Name:         "DisplayStyle",
+		NameOverride: "displaystyle",
+		Type:         "bool|force",
+		Doc:          "Specifies whether the expression is rendered in the display style, rather than the more compact inline style.",
+	},
+	"encoding": {
+		Name: "Encoding",
+		Type: "string",
+		Doc:  "Specifies the format of the annotation, such as application/x-tex.",
+	},
+	"fence": {
+		Name: "Fence",
+		Type: "bool|force",
+		Doc:  "Specifies whether the operator is a fence, such as a parenthesis.",
+	},
+	"largeop": {
+		Name:         "LargeOp",
+		NameOverride: "largeop",
+		Type:         "bool|force",
+		Doc:          "Specifies whether the operator is drawn larger in display style, such as a sum sign.",
+	},
+	"linethickness": {
+		Name:         "LineThickness",
+		NameOverride: "linethickness",
+		Type:         "string",
+		Doc:          "Sets the thickness of the fraction bar, such as \"0\" to hide it.",
+	},
+	"lspace": {
+		Name:         "LSpace",
+		NameOverride: "lspace",
+		Type:         "string",
+		Doc:          "Sets the space before the operator, such as \"0.2em\".",
+	},
+	"math-display": {
+		Name:         "Display",
+		NameOverride: "display",
+		Type:         "string",
+		Doc:          "Specifies whether the expression is rendered as a block or inline.",
+	},
+	"mathvariant": {
+		Name:         "MathVariant",
+		NameOverride: "mathvariant",
+		Type:         "string",
+		Doc:          "Sets the logical class of an identifier, such as normal to prevent single-character identifiers from being italicized.",
+	},
+	"rspace": {
+		Name:         "RSpace",
+		NameOverride: "rspace",
+		Type:         "string",
+		Doc:          "Sets the space after the operator, such as \"0.2em\".",
+	},
+	"scriptlevel": {
+		Name:         "ScriptLevel",
+		NameOverride: "scriptlevel",
+		Type:         "any",
+		Doc:          "Sets the math depth of the element, which determines its font size. Accepts absolute values or relative ones such as \"+1\".",
+	},
+	"stretchy": {
+		Name: "Stretchy",
+		Type: "bool|force",
+		Doc:  "Specifies whether the operator stretches to the size of the adjacent expressions.",
+	},
+	"symmetric": {
+		Name: "Symmetric",
+		Type: "bool|force",
+		Doc:  "Specifies whether a stretchy operator stays symmetric around the math axis.",
+	},
 }
 
 func attrsByNames(names ...string) []attr {
@@ -1679,13 +2578,87 @@ func withGlobalAttrs(attrs ...attr) []attr {
 		"draggable",
 		"hidden",
 		"id",
+		"key",
 		"lang",
 		"role",
+		"slot",
 		"spellcheck",
 		"style",
 		"styles",
 		"tabindex",
 		"title",
+		"transition",
+		"attribute",
+	)...)
+
+	sort.Slice(attrs, func(i, j int) bool {
+		return strings.Compare(attrs[i].Name, attrs[j].Name) <= 0
+	})
+
+	return attrs
+}
+
+func withSVGGlobalAttrs(attrs ...attr) []attr {
+	attrs = append(attrs, attrsByNames(
+		"aria-*",
+		"class",
+		"data-*",
+		"datasets",
+		"id",
+		"key",
+		"lang",
+		"role",
+		"style",
+		"styles",
+		"tabindex",
+		"transition",
+		"attribute",
+	)...)
+
+	sort.Slice(attrs, func(i, j int) bool {
+		return strings.Compare(attrs[i].Name, attrs[j].Name) <= 0
+	})
+
+	return attrs
+}
+
+func withSVGPresentationAttrs(attrs ...attr) []attr {
+	return withSVGGlobalAttrs(append(attrs, attrsByNames(
+		"clip-path",
+		"clip-rule",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"mask",
+		"opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-opacity",
+		"stroke-width",
+		"transform",
+		"vector-effect",
+	)...)...)
+}
+
+func withMathMLGlobalAttrs(attrs ...attr) []attr {
+	attrs = append(attrs, attrsByNames(
+		"aria-*",
+		"class",
+		"data-*",
+		"datasets",
+		"dir",
+		"displaystyle",
+		"id",
+		"key",
+		"mathvariant",
+		"role",
+		"scriptlevel",
+		"style",
+		"styles",
+		"tabindex",
 		"attribute",
 	)...)
 
@@ -2128,7 +3101,7 @@ import (
 )
 		`)
 
-	for _, t := range tags {
+	for _, t := range allTags() {
 		writeInterface(f, t)
 
 		switch t.Name {
@@ -2154,12 +3127,18 @@ import (
 			)
 
 		default:
+			var xmlns string
+			if t.Namespace != "" {
+				xmlns = "xmlns: " + t.Namespace + ","
+			}
+
 			fmt.Fprintf(f, `
-			// Returns an HTML element %s
+			// Returns %s element %s
 			func %s() HTML%s {
 				e := &html%s{
 					htmlElement: htmlElement{
 						tag: "%s",
+						%s
 						isSelfClosing: %v,
 					},
 				}
@@ -2167,11 +3146,13 @@ import (
 				return e
 			}
 			`,
+				article(t.kind()),
 				t.Doc,
 				t.Name,
 				t.Name,
 				t.Name,
-				strings.ToLower(t.Name),
+				t.tagName(),
+				xmlns,
 				t.Type == selfClosing,
 			)
 		}
@@ -2187,11 +3168,12 @@ import (
 
 func writeInterface(w io.Writer, t tag) {
 	fmt.Fprintf(w, `
-		// The interface that represents a "%s" HTML element.
+		// The interface that represents a "%s" %s element.
 		type HTML%s interface {
 			HTML
 		`,
-		strings.ToLower(t.Name),
+		t.tagName(),
+		t.kind(),
 		t.Name,
 	)
 
@@ -2357,6 +3339,16 @@ func writeStruct(w io.Writer, t tag) {
 		return e
 	}
 
+	func (e *html%s) setProps(v properties) HTML {
+		e.properties = v
+		return e
+	}
+
+	func (e *html%s) setTransition(v string) HTML {
+		e.transitionName = v
+		return e
+	}
+
 	func (e *html%s) setEvents(v eventHandlers) HTML {
 		e.eventHandlers = v
 		return e
@@ -2378,6 +3370,8 @@ func writeStruct(w io.Writer, t tag) {
 		t.Name,
 		t.Name,
 		t.Name,
+		t.Name,
+		t.Name,
 	)
 }
 
@@ -2388,7 +3382,7 @@ func writeAttrFunction(w io.Writer, a attr, t tag, isInterface bool) {
 
 	var attrName string
 	if a.NameOverride != "" {
-		attrName = strings.ToLower(a.NameOverride)
+		attrName = a.NameOverride
 	} else {
 		attrName = strings.ToLower(a.Name)
 	}
@@ -2423,6 +3417,15 @@ func writeAttrFunction(w io.Writer, a attr, t tag, isInterface bool) {
 			}`)
 		}
 
+	case "prop|value":
+		fmt.Fprintf(w, `%s(n string, v any) HTML%s`, a.Name, t.Name)
+		if !isInterface {
+			fmt.Fprintf(w, `{
+				e.setProp(n, v)
+				return e
+			}`)
+		}
+
 	case "aria|value":
 		fmt.Fprintf(w, `%s(k string, v any) HTML%s`, a.Name, t.Name)
 		if !isInterface {
@@ -2445,9 +3448,7 @@ func writeAttrFunction(w io.Writer, a attr, t tag, isInterface bool) {
 		fmt.Fprintf(w, `%s(s map[string]string) HTML%s`, a.Name, t.Name)
 		if !isInterface {
 			fmt.Fprintf(w, `{
-				for k, v := range s {
-					e.Style(k, v)
-				}
+				e.setStyles(s)
 				return e
 			}`)
 		}
@@ -2489,6 +3490,24 @@ func writeAttrFunction(w io.Writer, a attr, t tag, isInterface bool) {
 			}`, attrName)
 		}
 
+	case "key":
+		fmt.Fprintf(w, `%s(v any) HTML%s`, a.Name, t.Name)
+		if !isInterface {
+			fmt.Fprintln(w, `{
+				e.setKey(v)
+				return e
+			}`)
+		}
+
+	case "transition":
+		fmt.Fprintf(w, `%s(name string) HTML%s`, a.Name, t.Name)
+		if !isInterface {
+			fmt.Fprintln(w, `{
+				e.setTransition(name)
+				return e
+			}`)
+		}
+
 	case "xmlns":
 		fmt.Fprintf(w, `%s(v string) HTML%s`, a.Name, t.Name)
 		if !isInterface {
@@ -2547,9 +3566,13 @@ import (
 )
 		`)
 
-	for _, t := range tags {
+	for _, t := range allTags() {
 		fmt.Fprintln(f)
-		fmt.Fprintf(f, `func Test%s(t *testing.T) {`, t.Name)
+		testName := t.Name
+		if t.Namespace != "" {
+			testName = t.kind() + strings.TrimPrefix(t.Name, "Svg")
+		}
+		fmt.Fprintf(f, `func Test%s(t *testing.T) {`, testName)
 		fmt.Fprintln(f)
 
 		switch t.Name {
@@ -2565,6 +3588,7 @@ import (
 		fmt.Fprintln(f, `elem.setDepth(1)`)
 		fmt.Fprintln(f, `elem.setJSElement(nil)`)
 		fmt.Fprintln(f, `elem.setAttrs(nil)`)
+		fmt.Fprintln(f, `elem.setProps(nil)`)
 		fmt.Fprintln(f, `elem.setEvents(nil)`)
 		fmt.Fprintln(f, `elem.setParent(nil)`)
 		fmt.Fprintln(f, `elem.setBody(nil)`)
@@ -2573,7 +3597,7 @@ import (
 			fmt.Fprintf(f, `elem.%s(`, a.Name)
 
 			switch a.Type {
-			case "data|value", "aria|value", "attr|value":
+			case "data|value", "aria|value", "attr|value", "prop|value":
 				fmt.Fprintln(f, `"foo", "bar")`)
 
 			case "data|map":
@@ -2593,7 +3617,7 @@ import (
 			case "int":
 				fmt.Fprintln(f, `42)`)
 
-			case "string":
+			case "string", "transition":
 				fmt.Fprintln(f, `"foo")`)
 
 			case "url":
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"net/url"
+)
+
+const (
+	// The maximum number of redirections followed by a single navigation.
+	maxRouteRedirects = 10
+)
+
+// RouteGuard is a function that is called before the component of a guarded
+// route is created. It decides whether the navigation to the given destination
+// is allowed, canceled or redirected.
+//
+// Guards are evaluated both in the browser and when the page is pre-rendered
+// on the server, where a redirection results in a 302 Found response and a
+// cancellation in a 403 Forbidden response.
+//
+// Example:
+//
+//	func requireAuth(ctx app.Context, destination *url.URL) app.NavigationDecision {
+//	    if !isSignedIn(ctx) {
+//	        return app.RedirectNavigation("/signin")
+//	    }
+//	    return app.AllowNavigation()
+//	}
+type RouteGuard func(ctx Context, destination *url.URL) NavigationDecision
+
+// NavigationDecision represents the outcome of a route guard.
+type NavigationDecision struct {
+	action   navigationAction
+	redirect string
+}
+
+// AllowNavigation returns a decision that lets the navigation continue.
+func AllowNavigation() NavigationDecision {
+	return NavigationDecision{action: allowNavigation}
+}
+
+// CancelNavigation returns a decision that stops the navigation. In the
+// browser, the current page stays displayed.
+func CancelNavigation() NavigationDecision {
+	return NavigationDecision{action: cancelNavigation}
+}
+
+// RedirectNavigation returns a decision that replaces the navigation
+// destination by the given URL. Relative URLs are resolved against the
+// original destination.
+func RedirectNavigation(url string) NavigationDecision {
+	return NavigationDecision{
+		action:   redirectNavigation,
+		redirect: url,
+	}
+}
+
+// Allowed reports whether the navigation is allowed.
+func (d NavigationDecision) Allowed() bool {
+	return d.action == allowNavigation
+}
+
+// Canceled reports whether the navigation is canceled.
+func (d NavigationDecision) Canceled() bool {
+	return d.action == cancelNavigation
+}
+
+// Redirection returns the URL the navigation is redirected to. The boolean
+// reports whether the navigation is redirected.
+func (d NavigationDecision) Redirection() (string, bool) {
+	return d.redirect, d.action == redirectNavigation
+}
+
+type navigationAction int
+
+const (
+	allowNavigation navigationAction = iota
+	cancelNavigation
+	redirectNavigation
+)
+
+func guardNavigation(ctx Context, destination *url.URL, guards []RouteGuard) NavigationDecision {
+	for _, guard := range guards {
+		if d := guard(ctx, destination); !d.Allowed() {
+			return d
+		}
+	}
+	return AllowNavigation()
+}
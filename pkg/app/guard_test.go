@@ -0,0 +1,167 @@
+package app
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNavigationDecision(t *testing.T) {
+	t.Run("allow", func(t *testing.T) {
+		d := AllowNavigation()
+		require.True(t, d.Allowed())
+		require.False(t, d.Canceled())
+		_, redirected := d.Redirection()
+		require.False(t, redirected)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		d := CancelNavigation()
+		require.False(t, d.Allowed())
+		require.True(t, d.Canceled())
+		_, redirected := d.Redirection()
+		require.False(t, redirected)
+	})
+
+	t.Run("redirect", func(t *testing.T) {
+		d := RedirectNavigation("/signin")
+		require.False(t, d.Allowed())
+		require.False(t, d.Canceled())
+		redirect, redirected := d.Redirection()
+		require.True(t, redirected)
+		require.Equal(t, "/signin", redirect)
+	})
+}
+
+func TestGuardNavigation(t *testing.T) {
+	ctx := makeTestContext()
+	destination, _ := url.Parse("/admin")
+
+	t.Run("no guards allows navigation", func(t *testing.T) {
+		require.True(t, guardNavigation(ctx, destination, nil).Allowed())
+	})
+
+	t.Run("first blocking guard wins", func(t *testing.T) {
+		var calls []string
+		guards := []RouteGuard{
+			func(ctx Context, u *url.URL) NavigationDecision {
+				calls = append(calls, "allow")
+				return AllowNavigation()
+			},
+			func(ctx Context, u *url.URL) NavigationDecision {
+				calls = append(calls, "redirect")
+				return RedirectNavigation("/signin")
+			},
+			func(ctx Context, u *url.URL) NavigationDecision {
+				calls = append(calls, "cancel")
+				return CancelNavigation()
+			},
+		}
+
+		d := guardNavigation(ctx, destination, guards)
+		redirect, redirected := d.Redirection()
+		require.True(t, redirected)
+		require.Equal(t, "/signin", redirect)
+		require.Equal(t, []string{"allow", "redirect"}, calls)
+	})
+}
+
+func TestEngineNavigateWithGuards(t *testing.T) {
+	navigate := func(e *engineX, path string) {
+		destination, _ := url.Parse(path)
+		e.Navigate(destination, true)
+	}
+
+	newEngine := func(guard RouteGuard) *engineX {
+		e := newTestEngine()
+		e.routes.route("/hello", NewZeroComponentFactory(&hello{}))
+		e.routes.route("/signin", NewZeroComponentFactory(&bar{}))
+
+		g := makeRouteGroup(e.routes, "/admin", nil).Guard(guard)
+		g.Route("/", NewZeroComponentFactory(&foo{}))
+		return e
+	}
+
+	t.Run("allowed navigation is loaded", func(t *testing.T) {
+		e := newEngine(func(ctx Context, u *url.URL) NavigationDecision {
+			return AllowNavigation()
+		})
+
+		navigate(e, "/admin")
+		require.Equal(t, "/admin", e.lastVisitedURL.Path)
+		require.IsType(t, &foo{}, e.body.body()[0])
+	})
+
+	t.Run("canceled navigation keeps the current page", func(t *testing.T) {
+		var guarded *url.URL
+		e := newEngine(func(ctx Context, u *url.URL) NavigationDecision {
+			guarded = u
+			return CancelNavigation()
+		})
+
+		navigate(e, "/hello")
+		navigate(e, "/admin")
+		require.Equal(t, "/admin", guarded.Path)
+		require.Equal(t, "/hello", e.lastVisitedURL.Path)
+		require.IsType(t, &hello{}, e.body.body()[0])
+	})
+
+	t.Run("redirected navigation loads the redirection", func(t *testing.T) {
+		e := newEngine(func(ctx Context, u *url.URL) NavigationDecision {
+			return RedirectNavigation("/signin?next=" + url.QueryEscape(u.Path))
+		})
+
+		navigate(e, "/admin")
+		require.Equal(t, "/signin", e.lastVisitedURL.Path)
+		require.Equal(t, "/admin", e.lastVisitedURL.Query().Get("next"))
+		require.IsType(t, &bar{}, e.body.body()[0])
+	})
+
+	t.Run("redirection loop is stopped", func(t *testing.T) {
+		calls := 0
+		e := newEngine(func(ctx Context, u *url.URL) NavigationDecision {
+			calls++
+			return RedirectNavigation("/admin")
+		})
+
+		navigate(e, "/admin")
+		require.Equal(t, maxRouteRedirects+1, calls)
+		require.Nil(t, e.body)
+	})
+
+	t.Run("route guard is evaluated", func(t *testing.T) {
+		e := newTestEngine()
+		e.routes.routeWithPattern("user", "/users/{id}", NewZeroComponentFactory(&foo{}),
+			func(ctx Context, u *url.URL) NavigationDecision {
+				return CancelNavigation()
+			},
+		)
+
+		navigate(e, "/users/42")
+		require.Nil(t, e.body)
+	})
+
+	t.Run("route guards are evaluated after group guards", func(t *testing.T) {
+		var calls []string
+		guard := func(name string) RouteGuard {
+			return func(ctx Context, u *url.URL) NavigationDecision {
+				calls = append(calls, name)
+				return AllowNavigation()
+			}
+		}
+
+		e := newTestEngine()
+		g := makeRouteGroup(e.routes, "/admin", nil).Guard(guard("group"))
+		g.RouteWithPattern("/users/{id}", NewZeroComponentFactory(&foo{}), guard("route"))
+		g.Route("/", NewZeroComponentFactory(&bar{}))
+
+		navigate(e, "/admin/users/42")
+		require.Equal(t, []string{"group", "route"}, calls)
+		require.IsType(t, &foo{}, e.body.body()[0])
+
+		calls = nil
+		navigate(e, "/admin")
+		require.Equal(t, []string{"group"}, calls)
+	})
+}
@@ -1,5 +1,15 @@
 package app
 
+import "sort"
+
+const (
+	// The XML namespace of SVG elements.
+	svgNamespace = "http://www.w3.org/2000/svg"
+
+	// The XML namespace of MathML elements.
+	mathMLNamespace = "http://www.w3.org/1998/Math/MathML"
+)
+
 // HTML provides an interface for representing HTML elements within the
 // application.
 type HTML interface {
@@ -19,8 +29,13 @@ type HTML interface {
 	SelfClosing() bool
 
 	depth() uint
+	key() string
+	transition() string
+	setTransition(string) HTML
 	attrs() attributes
 	setAttrs(attributes) HTML
+	props() properties
+	setProps(properties) HTML
 	events() eventHandlers
 	setEvents(eventHandlers) HTML
 	setDepth(uint) UI
@@ -31,15 +46,18 @@ type HTML interface {
 }
 
 type htmlElement struct {
-	tag           string
-	xmlns         string
-	treeDepth     uint
-	isSelfClosing bool
-	jsElement     Value
-	attributes    attributes
-	eventHandlers eventHandlers
-	parentElement UI
-	children      []UI
+	tag            string
+	xmlns          string
+	treeDepth      uint
+	isSelfClosing  bool
+	keyValue       string
+	transitionName string
+	jsElement      Value
+	attributes     attributes
+	properties     properties
+	eventHandlers  eventHandlers
+	parentElement  UI
+	children       []UI
 }
 
 func (e *htmlElement) JSValue() Value {
@@ -66,6 +84,18 @@ func (e *htmlElement) depth() uint {
 	return e.treeDepth
 }
 
+func (e *htmlElement) key() string {
+	return e.keyValue
+}
+
+func (e *htmlElement) setKey(v any) {
+	e.keyValue = toString(v)
+}
+
+func (e *htmlElement) transition() string {
+	return e.transitionName
+}
+
 func (e *htmlElement) attrs() attributes {
 	return e.attributes
 }
@@ -77,6 +107,31 @@ func (e *htmlElement) setAttr(name string, value any) {
 	e.attributes.Set(name, value)
 }
 
+func (e *htmlElement) props() properties {
+	return e.properties
+}
+
+func (e *htmlElement) setProp(name string, value any) {
+	if e.properties == nil {
+		e.properties = make(properties)
+	}
+	e.properties.Set(name, value)
+}
+
+// setStyles sets the given CSS declarations sorted by property, producing the
+// same style attribute each time the element is rendered.
+func (e *htmlElement) setStyles(s map[string]string) {
+	properties := make([]string, 0, len(s))
+	for k := range s {
+		properties = append(properties, k)
+	}
+	sort.Strings(properties)
+
+	for _, k := range properties {
+		e.setAttr("style", k+":"+s[k])
+	}
+}
+
 func (e *htmlElement) events() eventHandlers {
 	return e.eventHandlers
 }
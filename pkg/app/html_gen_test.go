@@ -11,6 +11,7 @@ func TestA(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -31,11 +32,13 @@ func TestA(t *testing.T) {
 	elem.Href("hello %v", 42)
 	elem.HrefLang("hello %v", 42)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Media("hello %v", 42)
 	elem.Ping("hello %v", 42)
 	elem.Rel("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
@@ -43,6 +46,7 @@ func TestA(t *testing.T) {
 	elem.TabIndex(42)
 	elem.Target("hello %v", 42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 
 	h := func(ctx Context, e Event) {}
@@ -90,6 +94,7 @@ func TestAbbr(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -107,14 +112,17 @@ func TestAbbr(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -161,6 +169,7 @@ func TestAddress(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -178,14 +187,17 @@ func TestAddress(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -232,6 +244,7 @@ func TestArea(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -254,11 +267,13 @@ func TestArea(t *testing.T) {
 	elem.Href("hello %v", 42)
 	elem.HrefLang("hello %v", 42)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Media("hello %v", 42)
 	elem.Rel("hello %v", 42)
 	elem.Role("hello %v", 42)
 	elem.Shape("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
@@ -266,6 +281,7 @@ func TestArea(t *testing.T) {
 	elem.TabIndex(42)
 	elem.Target("hello %v", 42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 
 	h := func(ctx Context, e Event) {}
@@ -311,6 +327,7 @@ func TestArticle(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -328,14 +345,17 @@ func TestArticle(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -382,6 +402,7 @@ func TestAside(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -399,14 +420,17 @@ func TestAside(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -453,6 +477,7 @@ func TestAudio(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -475,6 +500,7 @@ func TestAudio(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Loop(true)
 	elem.Loop(false)
@@ -482,6 +508,7 @@ func TestAudio(t *testing.T) {
 	elem.Muted(false)
 	elem.Preload("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Src("hello %v", 42)
@@ -489,6 +516,7 @@ func TestAudio(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -558,6 +586,7 @@ func TestB(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -575,14 +604,17 @@ func TestB(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -629,6 +661,7 @@ func TestBase(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -647,8 +680,10 @@ func TestBase(t *testing.T) {
 	elem.Hidden(false)
 	elem.Href("hello %v", 42)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
@@ -656,6 +691,7 @@ func TestBase(t *testing.T) {
 	elem.TabIndex(42)
 	elem.Target("hello %v", 42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -700,6 +736,7 @@ func TestBdi(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -717,14 +754,17 @@ func TestBdi(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -771,6 +811,7 @@ func TestBdo(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -788,14 +829,17 @@ func TestBdo(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -842,6 +886,7 @@ func TestBlockquote(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -860,14 +905,17 @@ func TestBlockquote(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -914,6 +962,7 @@ func TestBody(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -931,14 +980,17 @@ func TestBody(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -999,6 +1051,7 @@ func TestBr(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1016,14 +1069,17 @@ func TestBr(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1068,6 +1124,7 @@ func TestButton(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1096,15 +1153,18 @@ func TestButton(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Name("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 	elem.Value(42)
 
@@ -1153,6 +1213,7 @@ func TestCanvas(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1171,14 +1232,17 @@ func TestCanvas(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Width(42)
 
 	h := func(ctx Context, e Event) {}
@@ -1226,6 +1290,7 @@ func TestCaption(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1243,14 +1308,17 @@ func TestCaption(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1297,6 +1365,7 @@ func TestCite(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1314,14 +1383,17 @@ func TestCite(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1368,6 +1440,7 @@ func TestCode(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1385,14 +1458,17 @@ func TestCode(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1439,6 +1515,7 @@ func TestCol(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1456,8 +1533,10 @@ func TestCol(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Span(42)
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
@@ -1465,6 +1544,7 @@ func TestCol(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1509,6 +1589,7 @@ func TestColGroup(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1526,8 +1607,10 @@ func TestColGroup(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Span(42)
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
@@ -1535,6 +1618,7 @@ func TestColGroup(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1581,6 +1665,7 @@ func TestData(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1598,14 +1683,17 @@ func TestData(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Value(42)
 
 	h := func(ctx Context, e Event) {}
@@ -1619,6 +1707,7 @@ func TestDataList(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1636,14 +1725,17 @@ func TestDataList(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1690,6 +1782,7 @@ func TestDd(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1707,14 +1800,17 @@ func TestDd(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1761,6 +1857,7 @@ func TestDel(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1780,14 +1877,17 @@ func TestDel(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1834,6 +1934,7 @@ func TestDetails(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1851,16 +1952,19 @@ func TestDetails(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Open(true)
 	elem.Open(false)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1908,6 +2012,7 @@ func TestDfn(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1925,14 +2030,17 @@ func TestDfn(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1979,6 +2087,7 @@ func TestDialog(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -1996,16 +2105,19 @@ func TestDialog(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Open(true)
 	elem.Open(false)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2052,6 +2164,7 @@ func TestDiv(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2069,14 +2182,17 @@ func TestDiv(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2123,6 +2239,7 @@ func TestDl(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2140,14 +2257,17 @@ func TestDl(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2194,6 +2314,7 @@ func TestDt(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2211,14 +2332,17 @@ func TestDt(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2265,6 +2389,7 @@ func TestElem(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2282,14 +2407,18 @@ func TestElem(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
+	elem.Prop("foo", "bar")
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.XMLNS("http://www.w3.org/2000/svg")
 
 	h := func(ctx Context, e Event) {}
@@ -2337,6 +2466,7 @@ func TestElemSelfClosing(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2354,14 +2484,18 @@ func TestElemSelfClosing(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
+	elem.Prop("foo", "bar")
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.XMLNS("http://www.w3.org/2000/svg")
 
 	h := func(ctx Context, e Event) {}
@@ -2407,6 +2541,7 @@ func TestEm(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2424,14 +2559,17 @@ func TestEm(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2478,6 +2616,7 @@ func TestEmbed(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2496,8 +2635,10 @@ func TestEmbed(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Src("hello %v", 42)
@@ -2505,6 +2646,7 @@ func TestEmbed(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 	elem.Width(42)
 
@@ -2574,6 +2716,7 @@ func TestFieldSet(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2594,15 +2737,18 @@ func TestFieldSet(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Name("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2649,6 +2795,7 @@ func TestFigCaption(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2666,14 +2813,17 @@ func TestFigCaption(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2720,6 +2870,7 @@ func TestFigure(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2737,14 +2888,17 @@ func TestFigure(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2791,6 +2945,7 @@ func TestFooter(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2808,14 +2963,17 @@ func TestFooter(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2862,6 +3020,7 @@ func TestForm(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2884,12 +3043,14 @@ func TestForm(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Method("hello %v", 42)
 	elem.Name("hello %v", 42)
 	elem.NoValidate(true)
 	elem.NoValidate(false)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
@@ -2897,6 +3058,7 @@ func TestForm(t *testing.T) {
 	elem.TabIndex(42)
 	elem.Target("hello %v", 42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -2943,6 +3105,7 @@ func TestH1(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -2960,14 +3123,17 @@ func TestH1(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3014,6 +3180,7 @@ func TestH2(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3031,14 +3198,17 @@ func TestH2(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3085,6 +3255,7 @@ func TestH3(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3102,14 +3273,17 @@ func TestH3(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3156,6 +3330,7 @@ func TestH4(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3173,14 +3348,17 @@ func TestH4(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3227,6 +3405,7 @@ func TestH5(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3244,14 +3423,17 @@ func TestH5(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3298,6 +3480,7 @@ func TestH6(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3315,14 +3498,17 @@ func TestH6(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3369,6 +3555,7 @@ func TestHead(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3386,14 +3573,17 @@ func TestHead(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3406,6 +3596,7 @@ func TestHeader(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3423,14 +3614,17 @@ func TestHeader(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3477,6 +3671,7 @@ func TestHr(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3494,14 +3689,17 @@ func TestHr(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3546,6 +3744,7 @@ func TestHtml(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3563,14 +3762,17 @@ func TestHtml(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3582,6 +3784,7 @@ func TestI(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3599,14 +3802,17 @@ func TestI(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -3653,6 +3859,7 @@ func TestIFrame(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3676,12 +3883,14 @@ func TestIFrame(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Loading("hello %v", 42)
 	elem.Name("hello %v", 42)
 	elem.ReferrerPolicy("hello %v", 42)
 	elem.Role("hello %v", 42)
 	elem.Sandbox("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Src("hello %v", 42)
@@ -3690,6 +3899,7 @@ func TestIFrame(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Width(42)
 
 	h := func(ctx Context, e Event) {}
@@ -3738,6 +3948,7 @@ func TestImg(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3761,9 +3972,11 @@ func TestImg(t *testing.T) {
 	elem.ID("hello %v", 42)
 	elem.IsMap(true)
 	elem.IsMap(false)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
 	elem.Sizes("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Src("hello %v", 42)
@@ -3772,6 +3985,7 @@ func TestImg(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.UseMap("hello %v", 42)
 	elem.Width(42)
 
@@ -3842,6 +4056,7 @@ func TestInput(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3879,6 +4094,7 @@ func TestInput(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.List("hello %v", 42)
 	elem.Max(42)
@@ -3895,6 +4111,7 @@ func TestInput(t *testing.T) {
 	elem.Required(false)
 	elem.Role("hello %v", 42)
 	elem.Size(42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Src("hello %v", 42)
@@ -3903,6 +4120,7 @@ func TestInput(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 	elem.Value(42)
 	elem.Width(42)
@@ -3951,6 +4169,7 @@ func TestIns(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -3968,14 +4187,17 @@ func TestIns(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4022,6 +4244,7 @@ func TestKbd(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4039,14 +4262,17 @@ func TestKbd(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4093,6 +4319,7 @@ func TestLabel(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4112,14 +4339,17 @@ func TestLabel(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4166,6 +4396,7 @@ func TestLegend(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4183,14 +4414,17 @@ func TestLegend(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4237,6 +4471,7 @@ func TestLi(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4254,14 +4489,17 @@ func TestLi(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Value(42)
 
 	h := func(ctx Context, e Event) {}
@@ -4309,6 +4547,7 @@ func TestLink(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4331,17 +4570,20 @@ func TestLink(t *testing.T) {
 	elem.Href("hello %v", 42)
 	elem.HrefLang("hello %v", 42)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Media("hello %v", 42)
 	elem.Rel("hello %v", 42)
 	elem.Role("hello %v", 42)
 	elem.Sizes("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 
 	h := func(ctx Context, e Event) {}
@@ -4388,6 +4630,7 @@ func TestMain(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4405,14 +4648,17 @@ func TestMain(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4459,6 +4705,7 @@ func TestMap(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4476,15 +4723,18 @@ func TestMap(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Name("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4531,6 +4781,7 @@ func TestMark(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4548,14 +4799,17 @@ func TestMark(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4602,6 +4856,7 @@ func TestMeta(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4622,16 +4877,19 @@ func TestMeta(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Name("hello %v", 42)
 	elem.Property("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4642,6 +4900,7 @@ func TestMeter(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4661,18 +4920,21 @@ func TestMeter(t *testing.T) {
 	elem.Hidden(false)
 	elem.High(42)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Low(42)
 	elem.Max(42)
 	elem.Min(42)
 	elem.Optimum(42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Value(42)
 
 	h := func(ctx Context, e Event) {}
@@ -4720,6 +4982,7 @@ func TestNav(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4737,14 +5000,17 @@ func TestNav(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4791,6 +5057,7 @@ func TestNoScript(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4808,14 +5075,17 @@ func TestNoScript(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -4828,6 +5098,7 @@ func TestObject(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4848,15 +5119,18 @@ func TestObject(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Name("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 	elem.UseMap("hello %v", 42)
 	elem.Width(42)
@@ -4929,6 +5203,7 @@ func TestOl(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -4946,10 +5221,12 @@ func TestOl(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Reversed(true)
 	elem.Reversed(false)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Start(42)
@@ -4957,6 +5234,7 @@ func TestOl(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 
 	h := func(ctx Context, e Event) {}
@@ -5004,6 +5282,7 @@ func TestOptGroup(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5023,15 +5302,18 @@ func TestOptGroup(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Label("hello %v", 42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5078,6 +5360,7 @@ func TestOption(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5097,17 +5380,20 @@ func TestOption(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Label("hello %v", 42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
 	elem.Selected(true)
 	elem.Selected(false)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Value(42)
 
 	h := func(ctx Context, e Event) {}
@@ -5155,6 +5441,7 @@ func TestOutput(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5174,15 +5461,18 @@ func TestOutput(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Name("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5229,6 +5519,7 @@ func TestP(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5246,14 +5537,17 @@ func TestP(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5300,6 +5594,7 @@ func TestParam(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5317,15 +5612,18 @@ func TestParam(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Name("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Value(42)
 
 	h := func(ctx Context, e Event) {}
@@ -5371,6 +5669,7 @@ func TestPicture(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5388,14 +5687,17 @@ func TestPicture(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5442,6 +5744,7 @@ func TestPre(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5459,14 +5762,17 @@ func TestPre(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5513,6 +5819,7 @@ func TestProgress(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5530,15 +5837,18 @@ func TestProgress(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Max(42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Value(42)
 
 	h := func(ctx Context, e Event) {}
@@ -5586,6 +5896,7 @@ func TestQ(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5604,14 +5915,17 @@ func TestQ(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5658,6 +5972,7 @@ func TestRp(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5675,14 +5990,17 @@ func TestRp(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5729,6 +6047,7 @@ func TestRt(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5746,14 +6065,17 @@ func TestRt(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5800,6 +6122,7 @@ func TestRuby(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5817,14 +6140,17 @@ func TestRuby(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5871,6 +6197,7 @@ func TestS(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5888,14 +6215,17 @@ func TestS(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -5942,6 +6272,7 @@ func TestSamp(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -5959,14 +6290,17 @@ func TestSamp(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6013,6 +6347,7 @@ func TestScript(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6036,8 +6371,10 @@ func TestScript(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Src("hello %v", 42)
@@ -6045,6 +6382,7 @@ func TestScript(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 
 	h := func(ctx Context, e Event) {}
@@ -6059,6 +6397,7 @@ func TestSection(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6076,14 +6415,17 @@ func TestSection(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6130,6 +6472,7 @@ func TestSelect(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6152,6 +6495,7 @@ func TestSelect(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Multiple(true)
 	elem.Multiple(false)
@@ -6160,12 +6504,14 @@ func TestSelect(t *testing.T) {
 	elem.Required(false)
 	elem.Role("hello %v", 42)
 	elem.Size(42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6212,6 +6558,7 @@ func TestSmall(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6229,14 +6576,17 @@ func TestSmall(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6283,6 +6633,7 @@ func TestSource(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6300,10 +6651,12 @@ func TestSource(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Media("hello %v", 42)
 	elem.Role("hello %v", 42)
 	elem.Sizes("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Src("hello %v", 42)
@@ -6312,6 +6665,7 @@ func TestSource(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 
 	h := func(ctx Context, e Event) {}
@@ -6357,6 +6711,7 @@ func TestSpan(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6374,14 +6729,17 @@ func TestSpan(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6428,6 +6786,7 @@ func TestStrong(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6445,14 +6804,17 @@ func TestStrong(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6499,6 +6861,7 @@ func TestStyle(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6516,15 +6879,18 @@ func TestStyle(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Media("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Type("hello %v", 42)
 
 	h := func(ctx Context, e Event) {}
@@ -6573,6 +6939,7 @@ func TestSub(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6590,14 +6957,17 @@ func TestSub(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6644,6 +7014,7 @@ func TestSummary(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6661,14 +7032,17 @@ func TestSummary(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6715,6 +7089,7 @@ func TestSup(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6732,14 +7107,17 @@ func TestSup(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6786,6 +7164,7 @@ func TestTable(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6803,14 +7182,17 @@ func TestTable(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6857,6 +7239,7 @@ func TestTBody(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6874,14 +7257,17 @@ func TestTBody(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -6928,6 +7314,7 @@ func TestTd(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -6947,15 +7334,18 @@ func TestTd(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
 	elem.Rowspan(42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7002,6 +7392,7 @@ func TestTemplate(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7019,14 +7410,17 @@ func TestTemplate(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7039,6 +7433,7 @@ func TestTextarea(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7063,6 +7458,7 @@ func TestTextarea(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.MaxLength(42)
 	elem.Name("hello %v", 42)
@@ -7073,12 +7469,14 @@ func TestTextarea(t *testing.T) {
 	elem.Required(false)
 	elem.Role("hello %v", 42)
 	elem.Rows(42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Wrap("hello %v", 42)
 
 	h := func(ctx Context, e Event) {}
@@ -7126,6 +7524,7 @@ func TestTFoot(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7143,14 +7542,17 @@ func TestTFoot(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7197,6 +7599,7 @@ func TestTh(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7217,16 +7620,19 @@ func TestTh(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
 	elem.Rowspan(42)
 	elem.Scope("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7273,6 +7679,7 @@ func TestTHead(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7290,14 +7697,17 @@ func TestTHead(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7344,6 +7754,7 @@ func TestTime(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7362,14 +7773,17 @@ func TestTime(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7416,6 +7830,7 @@ func TestTitle(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7433,14 +7848,17 @@ func TestTitle(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7453,6 +7871,7 @@ func TestTr(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7470,14 +7889,17 @@ func TestTr(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7524,6 +7946,7 @@ func TestU(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7541,14 +7964,17 @@ func TestU(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7595,6 +8021,7 @@ func TestUl(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7612,14 +8039,17 @@ func TestUl(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7666,6 +8096,7 @@ func TestVar(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7683,14 +8114,17 @@ func TestVar(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -7737,6 +8171,7 @@ func TestVideo(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7760,6 +8195,7 @@ func TestVideo(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Loop(true)
 	elem.Loop(false)
@@ -7768,6 +8204,7 @@ func TestVideo(t *testing.T) {
 	elem.Poster("hello %v", 42)
 	elem.Preload("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Src("hello %v", 42)
@@ -7775,6 +8212,7 @@ func TestVideo(t *testing.T) {
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
 	elem.Width(42)
 
 	h := func(ctx Context, e Event) {}
@@ -7845,6 +8283,7 @@ func TestWbr(t *testing.T) {
 	elem.setDepth(1)
 	elem.setJSElement(nil)
 	elem.setAttrs(nil)
+	elem.setProps(nil)
 	elem.setEvents(nil)
 	elem.setParent(nil)
 	elem.setBody(nil)
@@ -7862,14 +8301,3550 @@ func TestWbr(t *testing.T) {
 	elem.Hidden(true)
 	elem.Hidden(false)
 	elem.ID("hello %v", 42)
+	elem.Key(42)
 	elem.Lang("hello %v", 42)
 	elem.Role("hello %v", 42)
+	elem.Slot("foo")
 	elem.Spellcheck(true)
 	elem.Spellcheck(false)
 	elem.Style("margin", "%vpx", 42)
 	elem.Styles(map[string]string{"color": "pink"})
 	elem.TabIndex(42)
 	elem.Title("hello %v", 42)
+	elem.Transition("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGCircle(t *testing.T) {
+	elem := Circle()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.Cx(42)
+	elem.Cy(42)
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.PathLength(42)
+	elem.R(42)
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGClipPath(t *testing.T) {
+	elem := ClipPath()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPathUnits("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Role("hello %v", 42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGDefs(t *testing.T) {
+	elem := Defs()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Role("hello %v", 42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGDesc(t *testing.T) {
+	elem := Desc()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Role("hello %v", 42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGEllipse(t *testing.T) {
+	elem := Ellipse()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.Cx(42)
+	elem.Cy(42)
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.PathLength(42)
+	elem.Role("hello %v", 42)
+	elem.Rx(42)
+	elem.Ry(42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGForeignObject(t *testing.T) {
+	elem := ForeignObject()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Height(42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Role("hello %v", 42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+	elem.Width(42)
+	elem.X(42)
+	elem.Y(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGG(t *testing.T) {
+	elem := G()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGLine(t *testing.T) {
+	elem := Line()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.MarkerEnd("foo")
+	elem.MarkerStart("foo")
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.PathLength(42)
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+	elem.X1(42)
+	elem.X2(42)
+	elem.Y1(42)
+	elem.Y2(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGLinearGradient(t *testing.T) {
+	elem := LinearGradient()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.GradientTransform("foo")
+	elem.GradientUnits("foo")
+	elem.Href("hello %v", 42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Role("hello %v", 42)
+	elem.SpreadMethod("foo")
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+	elem.X1(42)
+	elem.X2(42)
+	elem.Y1(42)
+	elem.Y2(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGMarker(t *testing.T) {
+	elem := Marker()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.MarkerHeight(42)
+	elem.MarkerUnits("foo")
+	elem.MarkerWidth(42)
+	elem.Orient(42)
+	elem.PreserveAspectRatio("foo")
+	elem.RefX(42)
+	elem.RefY(42)
+	elem.Role("hello %v", 42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+	elem.ViewBox("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGMask(t *testing.T) {
+	elem := Mask()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Height(42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.MaskContentUnits("foo")
+	elem.MaskUnits("foo")
+	elem.Role("hello %v", 42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+	elem.Width(42)
+	elem.X(42)
+	elem.Y(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGPath(t *testing.T) {
+	elem := Path()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.D("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.MarkerEnd("foo")
+	elem.MarkerMid("foo")
+	elem.MarkerStart("foo")
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.PathLength(42)
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGPattern(t *testing.T) {
+	elem := Pattern()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Height(42)
+	elem.Href("hello %v", 42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.PatternContentUnits("foo")
+	elem.PatternTransform("foo")
+	elem.PatternUnits("foo")
+	elem.PreserveAspectRatio("foo")
+	elem.Role("hello %v", 42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+	elem.ViewBox("foo")
+	elem.Width(42)
+	elem.X(42)
+	elem.Y(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGPolygon(t *testing.T) {
+	elem := Polygon()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.MarkerEnd("foo")
+	elem.MarkerMid("foo")
+	elem.MarkerStart("foo")
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.PathLength(42)
+	elem.Points("foo")
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGPolyline(t *testing.T) {
+	elem := Polyline()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.MarkerEnd("foo")
+	elem.MarkerMid("foo")
+	elem.MarkerStart("foo")
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.PathLength(42)
+	elem.Points("foo")
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGRadialGradient(t *testing.T) {
+	elem := RadialGradient()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.Cx(42)
+	elem.Cy(42)
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fx(42)
+	elem.Fy(42)
+	elem.GradientTransform("foo")
+	elem.GradientUnits("foo")
+	elem.Href("hello %v", 42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.R(42)
+	elem.Role("hello %v", 42)
+	elem.SpreadMethod("foo")
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGRect(t *testing.T) {
+	elem := Rect()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.Height(42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.PathLength(42)
+	elem.Role("hello %v", 42)
+	elem.Rx(42)
+	elem.Ry(42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+	elem.Width(42)
+	elem.X(42)
+	elem.Y(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGStop(t *testing.T) {
+	elem := Stop()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Offset(42)
+	elem.Role("hello %v", 42)
+	elem.StopColor("foo")
+	elem.StopOpacity(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVG(t *testing.T) {
+	elem := Svg()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.Height(42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.PreserveAspectRatio("foo")
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+	elem.ViewBox("foo")
+	elem.Width(42)
+	elem.X(42)
+	elem.Y(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGA(t *testing.T) {
+	elem := SvgA()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.Href("hello %v", 42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Target("hello %v", 42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGImage(t *testing.T) {
+	elem := SvgImage()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.Height(42)
+	elem.Href("hello %v", 42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.PreserveAspectRatio("foo")
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+	elem.Width(42)
+	elem.X(42)
+	elem.Y(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGText(t *testing.T) {
+	elem := SvgText()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.DominantBaseline("foo")
+	elem.Dx(42)
+	elem.Dy(42)
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.FontSize(42)
+	elem.FontWeight(42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.TextAnchor("foo")
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+	elem.X(42)
+	elem.Y(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGTitle(t *testing.T) {
+	elem := SvgTitle()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Role("hello %v", 42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGSymbol(t *testing.T) {
+	elem := Symbol()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.PreserveAspectRatio("foo")
+	elem.RefX(42)
+	elem.RefY(42)
+	elem.Role("hello %v", 42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transition("foo")
+	elem.ViewBox("foo")
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGTspan(t *testing.T) {
+	elem := Tspan()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.DominantBaseline("foo")
+	elem.Dx(42)
+	elem.Dy(42)
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.FontSize(42)
+	elem.FontWeight(42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.TextAnchor("foo")
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+	elem.X(42)
+	elem.Y(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestSVGUse(t *testing.T) {
+	elem := Use()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ClipPath("foo")
+	elem.ClipRule("foo")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Fill("foo")
+	elem.FillOpacity(42)
+	elem.FillRule("foo")
+	elem.Height(42)
+	elem.Href("hello %v", 42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.Lang("hello %v", 42)
+	elem.Mask("foo")
+	elem.Opacity(42)
+	elem.Role("hello %v", 42)
+	elem.Stroke("foo")
+	elem.StrokeDashArray("foo")
+	elem.StrokeDashOffset(42)
+	elem.StrokeLineCap("foo")
+	elem.StrokeLineJoin("foo")
+	elem.StrokeOpacity(42)
+	elem.StrokeWidth(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Transform("foo")
+	elem.Transition("foo")
+	elem.VectorEffect("foo")
+	elem.Width(42)
+	elem.X(42)
+	elem.Y(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLAnnotation(t *testing.T) {
+	elem := Annotation()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.Encoding("foo")
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMath(t *testing.T) {
+	elem := Math()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.Display("foo")
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMfrac(t *testing.T) {
+	elem := Mfrac()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.LineThickness("foo")
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMi(t *testing.T) {
+	elem := Mi()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMn(t *testing.T) {
+	elem := Mn()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMo(t *testing.T) {
+	elem := Mo()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.Fence(true)
+	elem.Fence(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.LSpace("foo")
+	elem.LargeOp(true)
+	elem.LargeOp(false)
+	elem.MathVariant("foo")
+	elem.RSpace("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Stretchy(true)
+	elem.Stretchy(false)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.Symmetric(true)
+	elem.Symmetric(false)
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMover(t *testing.T) {
+	elem := Mover()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Accent(true)
+	elem.Accent(false)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMroot(t *testing.T) {
+	elem := Mroot()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMrow(t *testing.T) {
+	elem := Mrow()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMs(t *testing.T) {
+	elem := Ms()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMspace(t *testing.T) {
+	elem := Mspace()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Depth("foo")
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.Height(42)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+	elem.Width(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMsqrt(t *testing.T) {
+	elem := Msqrt()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMstyle(t *testing.T) {
+	elem := Mstyle()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMsub(t *testing.T) {
+	elem := Msub()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMsubsup(t *testing.T) {
+	elem := Msubsup()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMsup(t *testing.T) {
+	elem := Msup()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMtable(t *testing.T) {
+	elem := Mtable()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMtd(t *testing.T) {
+	elem := Mtd()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.ColumnSpan(42)
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.Rowspan(42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMtext(t *testing.T) {
+	elem := Mtext()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMtr(t *testing.T) {
+	elem := Mtr()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMunder(t *testing.T) {
+	elem := Munder()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.AccentUnder(true)
+	elem.AccentUnder(false)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLMunderover(t *testing.T) {
+	elem := Munderover()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Accent(true)
+	elem.Accent(false)
+	elem.AccentUnder(true)
+	elem.AccentUnder(false)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
+
+	h := func(ctx Context, e Event) {}
+	elem.On("click", h)
+	elem.OnBlur(h)
+	elem.OnChange(h)
+	elem.OnClick(h)
+	elem.OnContextMenu(h)
+	elem.OnCopy(h)
+	elem.OnCut(h)
+	elem.OnDblClick(h)
+	elem.OnDrag(h)
+	elem.OnDragEnd(h)
+	elem.OnDragEnter(h)
+	elem.OnDragLeave(h)
+	elem.OnDragOver(h)
+	elem.OnDragStart(h)
+	elem.OnDrop(h)
+	elem.OnFocus(h)
+	elem.OnInput(h)
+	elem.OnInvalid(h)
+	elem.OnKeyDown(h)
+	elem.OnKeyPress(h)
+	elem.OnKeyUp(h)
+	elem.OnMouseDown(h)
+	elem.OnMouseEnter(h)
+	elem.OnMouseLeave(h)
+	elem.OnMouseMove(h)
+	elem.OnMouseOut(h)
+	elem.OnMouseOver(h)
+	elem.OnMouseUp(h)
+	elem.OnPaste(h)
+	elem.OnReset(h)
+	elem.OnScroll(h)
+	elem.OnSearch(h)
+	elem.OnSelect(h)
+	elem.OnSubmit(h)
+	elem.OnWheel(h)
+	elem.Text("hello")
+	elem.Textf("hello %s", "Maxence")
+}
+
+func TestMathMLSemantics(t *testing.T) {
+	elem := Semantics()
+	elem.setDepth(1)
+	elem.setJSElement(nil)
+	elem.setAttrs(nil)
+	elem.setProps(nil)
+	elem.setEvents(nil)
+	elem.setParent(nil)
+	elem.setBody(nil)
+	elem.Aria("foo", "bar")
+	elem.Attr("foo", "bar")
+	elem.Class("foo bar")
+	elem.DataSet("foo", "bar")
+	elem.DataSets(map[string]any{"foo": "bar"})
+	elem.Dir("hello %v", 42)
+	elem.DisplayStyle(true)
+	elem.DisplayStyle(false)
+	elem.ID("hello %v", 42)
+	elem.Key(42)
+	elem.MathVariant("foo")
+	elem.Role("hello %v", 42)
+	elem.ScriptLevel(42)
+	elem.Style("margin", "%vpx", 42)
+	elem.Styles(map[string]string{"color": "pink"})
+	elem.TabIndex(42)
 
 	h := func(ctx Context, e Event) {}
 	elem.On("click", h)
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"crypto/sha1"
@@ -56,7 +57,9 @@ type Handler struct {
 	// DEFAULT: "{progress}%".
 	LoadingLabel string
 
-	// The page language.
+	// The page language. When a catalog is set with SetCatalog, it is the
+	// language used when none of the catalog languages matches the ones
+	// preferred by the client.
 	//
 	// DEFAULT: en.
 	Lang string
@@ -136,8 +139,7 @@ type Handler struct {
 
 	// The page body element.
 	//
-	// Note that the lang attribute is always overridden by the Handler.Lang
-	// value.
+	// Note that the lang attribute is always overridden by the page language.
 	//
 	// Default: Body().
 	Body func() HTMLBody
@@ -153,6 +155,7 @@ type Handler struct {
 	// Reserved keys:
 	// - GOAPP_VERSION
 	// - GOAPP_GOAPP_STATIC_RESOURCES_URL
+	// - GOAPP_HYDRATE
 	Env Environment
 
 	// The URLs that are launched in the app tab or window.
@@ -207,6 +210,37 @@ type Handler struct {
 	// The default fallback HTTP header is "Content-Length".
 	WasmContentLengthHeader string
 
+	// Reports whether the app reuses the pre-rendered page markup when it
+	// starts in the browser. When enabled, the nodes rendered on the server get
+	// attached to the components and their event handlers instead of being
+	// replaced, which prevents the page from flashing. Markup that does not
+	// match the browser rendering is replaced and reported in the console.
+	Hydrate bool
+
+	// Reports whether pre-rendered pages are streamed. When enabled, the page
+	// head is sent as soon as components are pre-rendered, letting the browser
+	// fetch styles, fonts and scripts while the data requested with
+	// Context.FetchData is awaited. The body is then sent progressively as it
+	// is encoded.
+	//
+	// Since the response status code, headers and head are sent before the
+	// data is received, changes made to them once the data is received are
+	// ignored. Components whose data is not received in time render their
+	// loading state and fetch it once the app is loaded in the browser.
+	StreamPages bool
+
+	// The cache where pre-rendered pages are kept. Pages are rendered for each
+	// request when nil.
+	PageCache *PageCache
+
+	// The maximum duration to wait for the data fetched with Context.FetchData
+	// when pre-rendering a page. The wait also ends when the request context is
+	// done. Pages are rendered with the data received so far once the wait is
+	// over.
+	//
+	// Default: no timeout other than the request context one.
+	PreRenderTimeout time.Duration
+
 	// The template used to generate app-worker.js. The template follows the
 	// text/template package model.
 	//
@@ -221,6 +255,7 @@ type Handler struct {
 	proxyResources       map[string]ProxyResource
 	cachedProxyResources *memoryCache
 	cachedPWAResources   *memoryCache
+	componentStyles      styleManager
 }
 
 func (h *Handler) init() {
@@ -372,6 +407,7 @@ func (h *Handler) makeAppJS() []byte {
 	h.Env["GOAPP_VERSION"] = h.Version
 	h.Env["GOAPP_STATIC_RESOURCES_URL"] = h.Resources.Resolve("/web")
 	h.Env["GOAPP_ROOT_PREFIX"] = h.Resources.Resolve("/")
+	h.Env["GOAPP_HYDRATE"] = strconv.FormatBool(h.Hydrate)
 
 	for k, v := range h.Env {
 		if err := os.Setenv(k, v); err != nil {
@@ -382,6 +418,8 @@ func (h *Handler) makeAppJS() []byte {
 		}
 	}
 
+	domOps, domCommandArgs := domOpsJSON()
+
 	var b bytes.Buffer
 	if err := template.
 		Must(template.New("app.js").Parse(appJS)).
@@ -393,6 +431,8 @@ func (h *Handler) makeAppJS() []byte {
 			WasmContentLengthHeader string
 			WorkerJS                string
 			AutoUpdateInterval      int64
+			DOMOps                  string
+			DOMCommandArgs          string
 		}{
 			Env:                     jsonString(h.Env),
 			LoadingLabel:            h.LoadingLabel,
@@ -401,6 +441,8 @@ func (h *Handler) makeAppJS() []byte {
 			WasmContentLengthHeader: h.WasmContentLengthHeader,
 			WorkerJS:                h.Resources.Resolve("/app-worker.js"),
 			AutoUpdateInterval:      h.AutoUpdateInterval.Milliseconds(),
+			DOMOps:                  domOps,
+			DOMCommandArgs:          domCommandArgs,
 		}); err != nil {
 		panic(errors.New("initializing app.js failed").Wrap(err))
 	}
@@ -669,19 +711,30 @@ func (h *Handler) serveProxyResource(resource ProxyResource, w http.ResponseWrit
 }
 
 func (h *Handler) servePage(w http.ResponseWriter, r *http.Request) {
-	if routed := routes.routed(r.URL.Path); !routed {
-		http.NotFound(w, r)
-		return
-	}
+	match, routed := routes.match(r.URL.Path)
 
-	ctx := context.Background()
+	ctx := r.Context()
+	if h.PreRenderTimeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, h.PreRenderTimeout)
+		defer cancel()
+	}
 
 	origin := *r.URL
 	origin.Scheme = "http"
 
 	page := makeRequestPage(&origin, h.Resources.Resolve)
 	page.SetTitle(h.Title)
-	page.SetLang(h.Lang)
+	// The language negotiated with the catalog, which makes cached pages vary.
+	var lang string
+	if catalog != nil {
+		lang = requestLang(r, h.Lang)
+		page.SetLang(lang)
+		page.Header().Set("Content-Language", lang)
+		page.Header().Set("Vary", "Accept-Language, Cookie")
+	} else {
+		page.SetLang(h.Lang)
+	}
 	page.SetDescription(h.Description)
 	page.SetAuthor(h.Author)
 	page.SetKeywords(h.Keywords...)
@@ -694,153 +747,44 @@ func (h *Handler) servePage(w http.ResponseWriter, r *http.Request) {
 		&page,
 		actionHandlers,
 	)
-	engine.Navigate(page.URL(), false)
+	engine.request = r
+	engine.data.prerendering = true
+
+	decision := guardNavigation(engine.baseContext(), page.URL(), match.guards)
+	if decision.Canceled() {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	if redirect, ok := decision.Redirection(); ok {
+		http.Redirect(w, r, redirect, http.StatusFound)
+		return
+	}
+
+	cacheTTL := h.PageCache.ttl(match.route)
+	if cacheTTL > 0 {
+		if cached, ok := h.PageCache.get(r, lang); ok {
+			h.serveRenderedPage(w, cached)
+			return
+		}
+	}
+
+	engine.open(page.URL(), match, routed, false)
+	defer untrackSignals(engine.body)
 	engine.ConsumeAll()
 
-	icon := h.Icon.SVG
-	if icon == "" {
-		icon = h.Icon.Default
+	if h.StreamPages && cacheTTL == 0 {
+		h.streamPage(w, r, engine, &page)
+		return
 	}
 
+	data := h.awaitPageData(r, engine)
+
 	var b bytes.Buffer
 	err := engine.Encode(&b, h.HTML().
 		Lang(page.Lang()).
 		privateBody(
-			Head().Body(
-				Meta().Charset("UTF-8"),
-				Meta().
-					Name("author").
-					Content(page.Author()),
-				Meta().
-					Name("description").
-					Content(page.Description()),
-				If(page.Keywords() != "", func() UI {
-					return Meta().
-						Name("keywords").
-						Content(page.Keywords())
-				}),
-				Meta().
-					Name("theme-color").
-					Content(h.ThemeColor),
-				Meta().
-					Name("viewport").
-					Content("width=device-width, initial-scale=1, maximum-scale=1, user-scalable=0, viewport-fit=cover"),
-				Meta().
-					Property("og:url").
-					Content(resolveOGResource(h.Domain, h.Resources.Resolve(page.URL().Path))),
-				Meta().
-					Property("og:title").
-					Content(page.Title()),
-				Meta().
-					Property("og:description").
-					Content(page.Description()),
-				Meta().
-					Property("og:type").
-					Content("website"),
-				Meta().
-					Property("og:image").
-					Content(resolveOGResource(h.Domain, page.Image())),
-				Range(page.twitterCardMap).Map(func(k string) UI {
-					v := page.twitterCardMap[k]
-					if v == "" {
-						return nil
-					}
-					if k == "twitter:image" {
-						v = resolveOGResource(h.Domain, v)
-					}
-					return Meta().
-						Name(k).
-						Content(v)
-				}),
-				Title().Text(page.Title()),
-				Range(h.Preconnect).Slice(func(i int) UI {
-					if resource := parseHTTPResource(h.Preconnect[i]); resource.URL != "" {
-						return resource.toLink().Rel("preconnect")
-					}
-					return nil
-				}),
-				Range(h.Fonts).Slice(func(i int) UI {
-					if resource := parseHTTPResource(h.Fonts[i]); resource.URL != "" {
-						return resource.toLink().
-							Type("font/" + strings.TrimPrefix(filepath.Ext(resource.URL), ".")).
-							Rel("preload").
-							As("font")
-					}
-					return nil
-				}),
-				Range(page.Preloads()).Slice(func(i int) UI {
-					p := page.Preloads()[i]
-					if p.Href == "" || p.As == "" {
-						return nil
-					}
-
-					if resource := parseHTTPResource(p.Href); resource.URL != "" {
-						return resource.toLink().
-							Type(p.Type).
-							Rel("preload").
-							As(p.As).
-							FetchPriority(p.FetchPriority)
-					}
-					return nil
-				}),
-				Range(h.Styles).Slice(func(i int) UI {
-					if resource := parseHTTPResource(h.Styles[i]); resource.URL != "" {
-						return resource.toLink().
-							Type("text/css").
-							Rel("preload").
-							As("style")
-					}
-					return nil
-				}),
-				Link().
-					Rel("icon").
-					Href(icon),
-				Link().
-					Rel("apple-touch-icon").
-					Href(h.Icon.AppleTouch),
-				Link().
-					Rel("manifest").
-					Href("/manifest.webmanifest"),
-				Range(h.Styles).Slice(func(i int) UI {
-					if resource := parseHTTPResource(h.Styles[i]); resource.URL != "" {
-						return resource.toLink().
-							Type("text/css").
-							Rel("stylesheet")
-					}
-					return nil
-				}),
-				Script().
-					Defer(true).
-					Src("/wasm_exec.js"),
-				Script().
-					Defer(true).
-					Src("/app.js"),
-				Range(h.Scripts).Slice(func(i int) UI {
-					if resource := parseHTTPResource(h.Scripts[i]); resource.URL != "" {
-						return resource.toScript()
-					}
-					return nil
-
-				}),
-				Range(h.RawHeaders).Slice(func(i int) UI {
-					return Raw(h.RawHeaders[i])
-				}),
-			),
-			h.Body().privateBody(
-				Aside().
-					ID("app-wasm-loader").
-					Class("goapp-app-info").
-					Body(
-						Img().
-							ID("app-wasm-loader-icon").
-							Class("goapp-logo goapp-spin").
-							Src(h.Icon.Default),
-						P().
-							ID("app-wasm-loader-label").
-							Class("goapp-label").
-							Text(page.loadingLabel),
-					),
-			),
+			h.pageHead(&page, h.pageStyles(engine)),
+			h.pageBody(&page, data),
 		))
 	if err != nil {
 		Log(errors.New("encoding html document failed").Wrap(err))
@@ -848,9 +792,274 @@ func (h *Handler) servePage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Length", strconv.Itoa(b.Len()))
+	rendered := &cachedPage{
+		statusCode: page.StatusCode(),
+		header:     page.Header().Clone(),
+		body:       b.Bytes(),
+	}
+	if cacheTTL > 0 && !engine.data.Pending() {
+		h.PageCache.set(r, lang, cacheTTL, rendered)
+	}
+	h.serveRenderedPage(w, rendered)
+}
+
+func (h *Handler) serveRenderedPage(w http.ResponseWriter, page *cachedPage) {
+	for k, v := range page.header {
+		w.Header()[k] = v
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(page.body)))
 	w.Header().Set("Content-Type", "text/html")
-	w.Write(b.Bytes())
+	w.WriteHeader(page.statusCode)
+	w.Write(page.body)
+}
+
+// streamPage writes the page head as soon as the components are pre-rendered,
+// allowing the browser to fetch the page resources while the data requested by
+// components is awaited. The body is then written progressively as it is
+// encoded.
+func (h *Handler) streamPage(w http.ResponseWriter, r *http.Request, engine *engineX, page *requestPage) {
+	for k, v := range page.Header() {
+		w.Header()[k] = v
+	}
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(page.StatusCode())
+
+	headStyles := h.pageStyles(engine)
+	streamed := make(map[string]bool, len(headStyles))
+	for _, s := range headStyles {
+		streamed[s.scope] = true
+	}
+
+	sw := newStreamWriter(w)
+	err := engine.EncodeStream(sw, h.HTML().
+		Lang(page.Lang()).
+		privateBody(h.pageHead(page, headStyles)),
+		func() (HTMLBody, []UI) {
+			body := h.pageBody(page, h.awaitPageData(r, engine))
+
+			// Components mounted once their data arrived have styles that
+			// were not written in the head.
+			var styles []UI
+			for _, s := range h.pageStyles(engine) {
+				if !streamed[s.scope] {
+					styles = append(styles, styleElement(s))
+				}
+			}
+			return body, styles
+		},
+	)
+	if err != nil {
+		Log(errors.New("streaming html document failed").
+			WithTag("path", r.URL.Path).
+			Wrap(err))
+	}
+}
+
+// awaitPageData waits for the data fetched by components and returns its
+// encoded representation.
+func (h *Handler) awaitPageData(r *http.Request, engine *engineX) []byte {
+	if err := engine.ConsumeData(); err != nil {
+		Log(errors.New("waiting for pre-rendering data failed").
+			WithTag("path", r.URL.Path).
+			Wrap(err))
+	}
+
+	data, err := engine.data.Encode()
+	if err != nil {
+		Log(errors.New("encoding pre-rendering data failed").
+			WithTag("path", r.URL.Path).
+			Wrap(err))
+	}
+	return data
+}
+
+// pageStyles returns the scoped styles of the components mounted by the given
+// engine, and records them to be collected into app.css by
+// GenerateStaticWebsite.
+func (h *Handler) pageStyles(engine *engineX) []componentStyle {
+	styles := engine.styles.List()
+	for _, s := range styles {
+		h.componentStyles.Add(s)
+	}
+	return styles
+}
+
+// styleElement returns the style element that contains the given component
+// styles. The "</" sequences of the styles are escaped so they can't close the
+// style element, which is harmless since "\/" is an escaped slash in CSS.
+func styleElement(s componentStyle) UI {
+	css := strings.ReplaceAll(s.css, "</", `<\/`)
+	return Raw(`<style data-goapp-styles="` + s.scope + `">` + css + `</style>`)
+}
+
+func (h *Handler) pageHead(page *requestPage, styles []componentStyle) HTMLHead {
+	icon := h.Icon.SVG
+	if icon == "" {
+		icon = h.Icon.Default
+	}
+
+	return Head().Body(
+		Meta().Charset("UTF-8"),
+		Meta().
+			Name("author").
+			Content(page.Author()),
+		Meta().
+			Name("description").
+			Content(page.Description()),
+		If(page.Keywords() != "", func() UI {
+			return Meta().
+				Name("keywords").
+				Content(page.Keywords())
+		}),
+		Meta().
+			Name("theme-color").
+			Content(h.ThemeColor),
+		Meta().
+			Name("viewport").
+			Content("width=device-width, initial-scale=1, maximum-scale=1, user-scalable=0, viewport-fit=cover"),
+		Meta().
+			Property("og:url").
+			Content(resolveOGResource(h.Domain, h.Resources.Resolve(page.URL().Path))),
+		Meta().
+			Property("og:title").
+			Content(page.Title()),
+		Meta().
+			Property("og:description").
+			Content(page.Description()),
+		Meta().
+			Property("og:type").
+			Content("website"),
+		Meta().
+			Property("og:image").
+			Content(resolveOGResource(h.Domain, page.Image())),
+		Range(page.twitterCardMap).Map(func(k string) UI {
+			v := page.twitterCardMap[k]
+			if v == "" {
+				return nil
+			}
+			if k == "twitter:image" {
+				v = resolveOGResource(h.Domain, v)
+			}
+			return Meta().
+				Name(k).
+				Content(v)
+		}),
+		Title().Text(page.Title()),
+		Range(h.Preconnect).Slice(func(i int) UI {
+			if resource := parseHTTPResource(h.Preconnect[i]); resource.URL != "" {
+				return resource.toLink().Rel("preconnect")
+			}
+			return nil
+		}),
+		Range(h.Fonts).Slice(func(i int) UI {
+			if resource := parseHTTPResource(h.Fonts[i]); resource.URL != "" {
+				return resource.toLink().
+					Type("font/" + strings.TrimPrefix(filepath.Ext(resource.URL), ".")).
+					Rel("preload").
+					As("font")
+			}
+			return nil
+		}),
+		Range(page.Preloads()).Slice(func(i int) UI {
+			p := page.Preloads()[i]
+			if p.Href == "" || p.As == "" {
+				return nil
+			}
+
+			if resource := parseHTTPResource(p.Href); resource.URL != "" {
+				return resource.toLink().
+					Type(p.Type).
+					Rel("preload").
+					As(p.As).
+					FetchPriority(p.FetchPriority)
+			}
+			return nil
+		}),
+		Range(h.Styles).Slice(func(i int) UI {
+			if resource := parseHTTPResource(h.Styles[i]); resource.URL != "" {
+				return resource.toLink().
+					Type("text/css").
+					Rel("preload").
+					As("style")
+			}
+			return nil
+		}),
+		Link().
+			Rel("icon").
+			Href(icon),
+		Link().
+			Rel("apple-touch-icon").
+			Href(h.Icon.AppleTouch),
+		Link().
+			Rel("manifest").
+			Href("/manifest.webmanifest"),
+		Range(h.Styles).Slice(func(i int) UI {
+			if resource := parseHTTPResource(h.Styles[i]); resource.URL != "" {
+				return resource.toLink().
+					Type("text/css").
+					Rel("stylesheet")
+			}
+			return nil
+		}),
+		Range(styles).Slice(func(i int) UI {
+			return styleElement(styles[i])
+		}),
+		Script().
+			Defer(true).
+			Src("/wasm_exec.js"),
+		Script().
+			Defer(true).
+			Src("/app.js"),
+		Range(h.Scripts).Slice(func(i int) UI {
+			if resource := parseHTTPResource(h.Scripts[i]); resource.URL != "" {
+				return resource.toScript()
+			}
+			return nil
+
+		}),
+		Range(h.RawHeaders).Slice(func(i int) UI {
+			return Raw(h.RawHeaders[i])
+		}),
+	)
+}
+
+func (h *Handler) pageBody(page *requestPage, data []byte) HTMLBody {
+	return h.Body().privateBody(
+		If(len(data) != 0, func() UI {
+			return Raw(`<script id="` + pageDataID + `" type="application/json">` + string(data) + `</script>`)
+		}),
+		Aside().
+			ID("app-wasm-loader").
+			Class("goapp-app-info").
+			Body(
+				Img().
+					ID("app-wasm-loader-icon").
+					Class("goapp-logo goapp-spin").
+					Src(h.Icon.Default),
+				P().
+					ID("app-wasm-loader-label").
+					Class("goapp-label").
+					Text(page.loadingLabel),
+			),
+	)
+}
+
+// newStreamWriter returns a buffered writer that sends its content to the
+// client each time its buffer is full or flushed.
+func newStreamWriter(w http.ResponseWriter) *bufio.Writer {
+	return bufio.NewWriter(flushWriter{ResponseWriter: w})
+}
+
+type flushWriter struct {
+	http.ResponseWriter
+}
+
+func (w flushWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
 }
 
 func (h *Handler) serveLibrary(w http.ResponseWriter, r *http.Request, library []byte) {
@@ -4,17 +4,40 @@
 package app
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/maxence-charriere/go-app/v9/pkg/cache"
 	"github.com/stretchr/testify/require"
 )
 
 func init() {
 	Route("/", func() Composer { return &preRenderTestCompo{} })
+
+	Route("/gone", func() Composer { return &statusTestCompo{} })
+	Route("/request", func() Composer { return &requestTestCompo{} })
+	Route("/data", func() Composer { return &dataTestCompo{} })
+	Route("/styled", func() Composer { return &styledTestCompo{} })
+	Route("/late-styled", func() Composer { return &lateStyledTestCompo{} })
+	RouteWithPattern("/cached/{id}", func() Composer { return &cacheTestCompo{} })
+
+	guarded := Group("/guarded", nil)
+	guarded.Guard(func(ctx Context, u *url.URL) NavigationDecision {
+		return RedirectNavigation("/signin")
+	}).Route("/redirect", func() Composer { return &preRenderTestCompo{} })
+	guarded.Guard(func(ctx Context, u *url.URL) NavigationDecision {
+		return CancelNavigation()
+	}).Route("/cancel", func() Composer { return &preRenderTestCompo{} })
+	guarded.Guard(func(ctx Context, u *url.URL) NavigationDecision {
+		return AllowNavigation()
+	}).Route("/allow", func() Composer { return &preRenderTestCompo{} })
 }
 
 type preRenderTestCompo struct {
@@ -29,6 +52,113 @@ func (c *preRenderTestCompo) Render() UI {
 		)
 }
 
+type statusTestCompo struct {
+	Compo
+}
+
+func (c *statusTestCompo) OnPreRender(ctx Context) {
+	ctx.Page().SetStatusCode(http.StatusGone)
+	ctx.Page().Header().Set("Cache-Control", "max-age=60")
+	ctx.Page().Header().Add("Vary", "Accept-Language")
+}
+
+func (c *statusTestCompo) Render() UI {
+	return Div().ID("gone")
+}
+
+var cacheTestRenders atomic.Int64
+
+type cacheTestCompo struct {
+	Compo
+}
+
+func (c *cacheTestCompo) Render() UI {
+	return Div().
+		ID("cached").
+		Text(cacheTestRenders.Add(1))
+}
+
+type requestContextKey struct{}
+
+type requestTestCompo struct {
+	Compo
+
+	user    string
+	agent   string
+	session string
+	address string
+}
+
+func (c *requestTestCompo) OnPreRender(ctx Context) {
+	r := ctx.Request()
+	c.agent = r.Header.Get("User-Agent")
+	c.address = r.RemoteAddr
+	if cookie, err := r.Cookie("session"); err == nil {
+		c.session = cookie.Value
+	}
+	c.user, _ = ctx.Value(requestContextKey{}).(string)
+}
+
+func (c *requestTestCompo) Render() UI {
+	return Ul().Body(
+		Li().ID("user").Text(c.user),
+		Li().ID("agent").Text(c.agent),
+		Li().ID("session").Text(c.session),
+		Li().ID("address").Text(c.address),
+	)
+}
+
+type dataTestCompo struct {
+	Compo
+
+	Posts []string
+}
+
+func (c *dataTestCompo) OnPreRender(ctx Context) {
+	delay, _ := time.ParseDuration(ctx.Page().URL().Query().Get("delay"))
+
+	ctx.FetchData("posts", &c.Posts, func(ctx context.Context, v any) error {
+		select {
+		case <-time.After(delay):
+			*v.(*[]string) = []string{"<hello>", "world"}
+			return nil
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+func (c *dataTestCompo) Render() UI {
+	return Ul().ID("posts").Body(
+		Range(c.Posts).Slice(func(i int) UI {
+			return Li().Text(c.Posts[i])
+		}),
+	)
+}
+
+type lateStyledTestCompo struct {
+	Compo
+
+	Posts []string
+}
+
+func (c *lateStyledTestCompo) OnPreRender(ctx Context) {
+	ctx.FetchData("posts", &c.Posts, func(ctx context.Context, v any) error {
+		time.Sleep(time.Millisecond * 10)
+		*v.(*[]string) = []string{"hello"}
+		return nil
+	})
+}
+
+func (c *lateStyledTestCompo) Render() UI {
+	return Div().Body(
+		If(len(c.Posts) != 0, func() UI {
+			return &otherStyledTestCompo{}
+		}),
+	)
+}
+
 func TestHandlerServePageWithLocalDir(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/", nil)
 	w := httptest.NewRecorder()
@@ -153,6 +283,277 @@ func TestHandlerServePageWithGitHubPages(t *testing.T) {
 	t.Log(body)
 }
 
+func TestHandlerServePageWithGuards(t *testing.T) {
+	t.Run("allowed page is rendered", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/guarded/allow", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{}
+		h.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `<div id="pre-render-ok">`)
+	})
+
+	t.Run("redirected page responds with a redirection", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/guarded/redirect", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{}
+		h.ServeHTTP(w, r)
+		require.Equal(t, http.StatusFound, w.Code)
+		require.Equal(t, "/signin", w.Header().Get("Location"))
+		require.NotContains(t, w.Body.String(), `<div id="pre-render-ok">`)
+	})
+
+	t.Run("canceled page is forbidden", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/guarded/cancel", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{}
+		h.ServeHTTP(w, r)
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.NotContains(t, w.Body.String(), `<div id="pre-render-ok">`)
+	})
+}
+
+func TestHandlerServePageWithStatusCode(t *testing.T) {
+	t.Run("page sets status code and headers", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/gone", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{}
+		h.ServeHTTP(w, r)
+		require.Equal(t, http.StatusGone, w.Code)
+		require.Equal(t, "max-age=60", w.Header().Get("Cache-Control"))
+		require.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+		require.Equal(t, "text/html", w.Header().Get("Content-Type"))
+		require.Contains(t, w.Body.String(), `<div id="gone">`)
+	})
+
+	t.Run("not routed page is not found", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/not-routed", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{}
+		h.ServeHTTP(w, r)
+		require.Equal(t, http.StatusNotFound, w.Code)
+		require.Contains(t, w.Body.String(), `goapp-notfound-title`)
+	})
+}
+
+func TestHandlerServePageWithRequest(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/request", nil)
+	r.Header.Set("User-Agent", "go-app-test")
+	r.AddCookie(&http.Cookie{Name: "session", Value: "42"})
+	r.RemoteAddr = "192.0.2.1:1234"
+	r = r.WithContext(context.WithValue(r.Context(), requestContextKey{}, "maxence"))
+	w := httptest.NewRecorder()
+
+	h := Handler{}
+	h.ServeHTTP(w, r)
+
+	body := w.Body.String()
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, body, `<li id="user">maxence</li>`)
+	require.Contains(t, body, `<li id="agent">go-app-test</li>`)
+	require.Contains(t, body, `<li id="session">42</li>`)
+	require.Contains(t, body, `<li id="address">192.0.2.1:1234</li>`)
+}
+
+func TestHandlerServePageWithData(t *testing.T) {
+	t.Run("page is rendered with fetched data", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/data?delay=10ms", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{}
+		h.ServeHTTP(w, r)
+
+		body := w.Body.String()
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, body, `<li>&lt;hello&gt;</li>`)
+		require.Contains(t, body, `<li>world</li>`)
+		require.Contains(t, body, `<script id="goapp-data" type="application/json">{"posts":["\u003chello\u003e","world"]}</script>`)
+	})
+
+	t.Run("page is rendered without data when timeout is reached", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/data?delay=1m", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{PreRenderTimeout: time.Millisecond * 10}
+		h.ServeHTTP(w, r)
+
+		body := w.Body.String()
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, body, `<ul id="posts">`)
+		require.NotContains(t, body, `<li>world</li>`)
+		require.NotContains(t, body, `goapp-data`)
+	})
+}
+
+func TestHandlerServePageWithStyles(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/styled", nil)
+	w := httptest.NewRecorder()
+
+	h := Handler{}
+	h.ServeHTTP(w, r)
+
+	s := componentStyleOf(&styledTestCompo{})
+	body := w.Body.String()
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, body, `<style data-goapp-styles="`+s.scope+`">`+s.css+`</style>`)
+	require.Contains(t, body, `<span data-goapp-scope="`+s.scope+`">styled</span>`)
+	require.Regexp(t, `<div (class="styled" data-goapp-scope="`+s.scope+`"|data-goapp-scope="`+s.scope+`" class="styled")>`, body)
+	require.Equal(t, s.css, h.componentStyles.CSS())
+}
+
+func TestStyleElement(t *testing.T) {
+	s := componentStyle{
+		scope: "s1",
+		css:   `.a::after { content: "</style><script>"; }`,
+	}
+	require.Equal(t,
+		`<style data-goapp-styles="s1">.a::after { content: "<\/style><script>"; }</style>`,
+		HTMLString(styleElement(s)),
+	)
+}
+
+func TestHandlerServePageWithStream(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/data?delay=10ms", nil)
+	w := httptest.NewRecorder()
+
+	h := Handler{
+		Title:       "streamed",
+		StreamPages: true,
+	}
+	h.ServeHTTP(w, r)
+
+	body := w.Body.String()
+	require.Equal(t, http.StatusOK, w.Code)
+	require.True(t, w.Flushed)
+	require.Empty(t, w.Header().Get("Content-Length"))
+	require.Equal(t, "text/html", w.Header().Get("Content-Type"))
+	require.Contains(t, body, `<title>streamed</title>`)
+	require.Contains(t, body, `<li>world</li>`)
+	require.Contains(t, body, `<script id="goapp-data" type="application/json">{"posts":["\u003chello\u003e","world"]}</script>`)
+	require.Regexp(t, `</html>$`, body)
+}
+
+func TestHandlerServePageWithStreamAndLateStyles(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/late-styled", nil)
+	w := httptest.NewRecorder()
+
+	h := Handler{StreamPages: true}
+	h.ServeHTTP(w, r)
+
+	s := componentStyleOf(&otherStyledTestCompo{})
+	style := `<style data-goapp-styles="` + s.scope + `">` + s.css + `</style>`
+	body := w.Body.String()
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, body, `<body>`+"\n    "+style+"\n    <div>")
+	require.Contains(t, body, `<p data-goapp-scope="`+s.scope+`">other</p>`)
+	require.Equal(t, s.css, h.componentStyles.CSS())
+}
+
+func TestHandlerServePageWithCache(t *testing.T) {
+	serve := func(h *Handler, target string, header map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		for k, v := range header {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	newHandler := func() *Handler {
+		return &Handler{
+			PageCache: &PageCache{
+				Storage: &cache.LRU{ItemTTL: time.Hour},
+				TTLs: map[string]time.Duration{
+					"/cached/{id}":    time.Minute,
+					"/guarded/cancel": time.Minute,
+				},
+				VaryQuery:    []string{"page"},
+				VaryLanguage: true,
+			},
+		}
+	}
+
+	t.Run("cached page is not rendered again", func(t *testing.T) {
+		h := newHandler()
+
+		first := serve(h, "/cached/1", nil)
+		require.Equal(t, http.StatusOK, first.Code)
+		renders := cacheTestRenders.Load()
+
+		second := serve(h, "/cached/1?utm_source=test", nil)
+		require.Equal(t, http.StatusOK, second.Code)
+		require.Equal(t, renders, cacheTestRenders.Load())
+		require.Equal(t, first.Body.String(), second.Body.String())
+		require.Equal(t, first.Header().Get("Content-Length"), second.Header().Get("Content-Length"))
+	})
+
+	t.Run("cached page varies with path query and language", func(t *testing.T) {
+		h := newHandler()
+
+		serve(h, "/cached/1", nil)
+		renders := cacheTestRenders.Load()
+
+		serve(h, "/cached/2", nil)
+		serve(h, "/cached/1?page=2", nil)
+		serve(h, "/cached/1", map[string]string{"Accept-Language": "fr-FR"})
+		require.Equal(t, renders+3, cacheTestRenders.Load())
+		require.Equal(t, 4, h.PageCache.Storage.Len())
+	})
+
+	t.Run("invalidated page is rendered again", func(t *testing.T) {
+		h := newHandler()
+
+		serve(h, "/cached/1", nil)
+		serve(h, "/cached/1?page=2", nil)
+		serve(h, "/cached/2", nil)
+		renders := cacheTestRenders.Load()
+
+		h.PageCache.Invalidate(context.Background(), "/cached/1")
+		serve(h, "/cached/1", nil)
+		serve(h, "/cached/1?page=2", nil)
+		serve(h, "/cached/2", nil)
+		require.Equal(t, renders+2, cacheTestRenders.Load())
+
+		h.PageCache.InvalidateAll(context.Background())
+		serve(h, "/cached/2", nil)
+		require.Equal(t, renders+3, cacheTestRenders.Load())
+	})
+
+	t.Run("expired page is rendered again", func(t *testing.T) {
+		h := newHandler()
+		h.PageCache.TTLs["/cached/{id}"] = time.Nanosecond
+
+		serve(h, "/cached/1", nil)
+		renders := cacheTestRenders.Load()
+
+		time.Sleep(time.Millisecond)
+		serve(h, "/cached/1", nil)
+		require.Equal(t, renders+1, cacheTestRenders.Load())
+	})
+
+	t.Run("guards are evaluated before serving cached page", func(t *testing.T) {
+		h := newHandler()
+
+		w := serve(h, "/guarded/cancel", nil)
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.Zero(t, h.PageCache.Storage.Len())
+	})
+
+	t.Run("page without ttl is not cached", func(t *testing.T) {
+		h := newHandler()
+
+		w := serve(h, "/", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Zero(t, h.PageCache.Storage.Len())
+	})
+}
+
 func TestHandlerServeWasmExecJS(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/wasm_exec.js", nil)
 	w := httptest.NewRecorder()
@@ -184,6 +585,8 @@ func TestHandlerServeAppJSWithLocalDir(t *testing.T) {
 	require.Contains(t, body, `"GOAPP_STATIC_RESOURCES_URL":"/web"`)
 	require.Contains(t, body, `"GOAPP_ROOT_PREFIX":"/"`)
 	require.Contains(t, body, `"GOAPP_INTERNAL_URLS":"[\"https://redirect.me\"]"`)
+	require.Contains(t, body, `const goappDOMOps = {"addEventListener":12,`)
+	require.Contains(t, body, `const goappDOMCommandArgs = [3,2,`)
 }
 
 func TestHandlerServeAppJSWithRemoteBucket(t *testing.T) {
@@ -203,6 +606,7 @@ func TestHandlerServeAppJSWithRemoteBucket(t *testing.T) {
 	require.Contains(t, body, "GOAPP_VERSION")
 	require.Contains(t, body, `"GOAPP_STATIC_RESOURCES_URL":"https://storage.googleapis.com/go-app/web"`)
 	require.Contains(t, body, `"GOAPP_ROOT_PREFIX":"/"`)
+	require.Contains(t, body, `"GOAPP_HYDRATE":"false"`)
 }
 
 func TestHandlerServeAppJSWithGitHubPages(t *testing.T) {
@@ -0,0 +1,316 @@
+package app
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+// DOM node types, as reported by the nodeType property of a node.
+const (
+	elementNode = 1
+	textNode    = 3
+	commentNode = 8
+)
+
+// Hydrate mounts the given UI element by attaching it to the given DOM node,
+// previously rendered on the server, instead of creating a new one. Parts of
+// the node that do not match the element are replaced by newly mounted nodes
+// and each mismatch is reported with Log.
+//
+// The parent argument is the JavaScript value of the parent of node. When node
+// is nil, the element is mounted and appended to parent.
+func (m nodeManager) Hydrate(ctx Context, depth uint, v UI, parent, node Value) (UI, error) {
+	ctx = m.context(ctx, v)
+
+	switch v := v.(type) {
+	case *text:
+		return m.hydrateText(ctx, depth, v, parent, node)
+
+	case HTML:
+		return m.hydrateHTML(ctx, depth, v, parent, node)
+
+	case Composer:
+		return m.hydrateComponent(ctx, depth, v, parent, node)
+
+	case *raw:
+		return m.hydrateRawHTML(ctx, depth, v, parent, node)
+
+	case *portal:
+		return m.hydratePortal(ctx, depth, v, parent, node)
+
+	default:
+		return nil, errors.New("unsupported element").
+			WithTag("type", reflect.TypeOf(v)).
+			WithTag("depth", depth)
+	}
+}
+
+func (m nodeManager) hydrateText(ctx Context, depth uint, v *text, parent, node Value) (UI, error) {
+	if v.Mounted() {
+		return nil, errors.New("text is already mounted").
+			WithTag("parent-type", reflect.TypeOf(v.parent())).
+			WithTag("preview-value", previewText(v.value))
+	}
+
+	if domNodeType(node) != textNode ||
+		strings.TrimSpace(node.Get("nodeValue").String()) != strings.TrimSpace(v.value) {
+		return m.remount(ctx, depth, v, parent, node)
+	}
+
+	if node.Get("nodeValue").String() != v.value {
+		node.setNodeValue(v.value)
+	}
+	v.jsvalue = node
+	return v, nil
+}
+
+func (m nodeManager) hydrateHTML(ctx Context, depth uint, v HTML, parent, node Value) (UI, error) {
+	if v.Mounted() {
+		return nil, errors.New("html element is already mounted").
+			WithTag("parent-type", reflect.TypeOf(v.parent())).
+			WithTag("type", reflect.TypeOf(v)).
+			WithTag("tag", v.Tag()).
+			WithTag("depth", v.depth())
+	}
+
+	if domNodeType(node) != elementNode ||
+		!strings.EqualFold(node.Get("nodeName").String(), v.Tag()) {
+		return m.remount(ctx, depth, v, parent, node)
+	}
+
+	v = v.setJSElement(node)
+	m.mountHTMLAttributes(ctx, v)
+	m.mountHTMLProperties(v)
+	m.mountHTMLEventHandlers(ctx, v)
+	v = v.setDepth(depth).(HTML)
+
+	domChildren := hydrationChildren(node)
+	children := v.body()
+	j := 0
+	for i, child := range children {
+		var domChild Value
+		if j < len(domChildren) {
+			domChild = domChildren[j]
+		}
+
+		textChild, isText := child.(*text)
+		if isText && i+1 < len(children) && domNodeType(domChild) == textNode {
+			if _, nextIsText := children[i+1].(*text); nextIsText {
+				if rest := splitHydrationText(domChild, textChild.value); rest != nil {
+					domChildren = append(domChildren[:j+1], append([]Value{rest}, domChildren[j+1:]...)...)
+				}
+			}
+		}
+
+		var err error
+		child = adopt(v, child)
+		if isText && domNodeType(domChild) != textNode {
+			// The server encoding skips empty texts, which leaves them without
+			// a matching node.
+			if textChild.value != "" {
+				logHydrationMismatch(child, depth+1, domChild)
+			}
+			if child, err = m.Mount(ctx, depth+1, child); err == nil {
+				v.JSValue().insertBefore(child, wrapperOrNil(domChild))
+			}
+		} else {
+			child, err = m.Hydrate(ctx, depth+1, child, v.JSValue(), domChild)
+
+			// Portals, including the ones that are component roots, are
+			// inserted before the node instead of being matched with it.
+			if err == nil && !isPortalRoot(child) {
+				j++
+			}
+		}
+		if err != nil {
+			return nil, errors.New("hydrating child failed").
+				WithTag("type", reflect.TypeOf(v)).
+				WithTag("tag", v.Tag()).
+				WithTag("depth", depth).
+				WithTag("index", i).
+				Wrap(err)
+		}
+
+		child = child.setParent(v)
+		children[i] = child
+	}
+
+	for ; j < len(domChildren); j++ {
+		logHydrationMismatch(nil, depth+1, domChildren[j])
+		v.JSValue().removeChild(domChildren[j])
+	}
+
+	return v, nil
+}
+
+func (m nodeManager) hydrateComponent(ctx Context, depth uint, v Composer, parent, node Value) (UI, error) {
+	return m.mountComponentWith(ctx, depth, v, func(root UI) (UI, error) {
+		return m.Hydrate(ctx, depth+1, adopt(v, root), parent, node)
+	})
+}
+
+func (m nodeManager) hydrateRawHTML(ctx Context, depth uint, v *raw, parent, node Value) (UI, error) {
+	if v.Mounted() {
+		return nil, errors.New("raw html is already mounted").
+			WithTag("parent-type", reflect.TypeOf(v.parent())).
+			WithTag("type", reflect.TypeOf(v)).
+			WithTag("depth", v.depth()).
+			WithTag("raw-preview", previewText(v.value))
+	}
+
+	if domNodeType(node) != elementNode ||
+		!strings.EqualFold(node.Get("nodeName").String(), v.tag) {
+		return m.remount(ctx, depth, v, parent, node)
+	}
+
+	v.jsElement = node
+	return v, nil
+}
+
+// isPortalRoot reports whether the given element is a portal or a component
+// whose root, once the roots of nested components are resolved, is a portal.
+func isPortalRoot(v UI) bool {
+	for {
+		switch e := v.(type) {
+		case *portal:
+			return true
+
+		case Composer:
+			if v = e.root(); v == nil {
+				return false
+			}
+
+		default:
+			return false
+		}
+	}
+}
+
+// hydratePortal mounts the given portal and inserts it before the given node.
+// Portals are not pre-rendered and therefore have no node to be attached to.
+func (m nodeManager) hydratePortal(ctx Context, depth uint, v *portal, parent, node Value) (UI, error) {
+	mounted, err := m.Mount(ctx, depth, v)
+	if err != nil {
+		return nil, err
+	}
+	parent.insertBefore(mounted, wrapperOrNil(node))
+	return mounted, nil
+}
+
+// remount mounts the given element from scratch and puts it in place of the
+// given mismatching node.
+func (m nodeManager) remount(ctx Context, depth uint, v UI, parent, node Value) (UI, error) {
+	logHydrationMismatch(v, depth, node)
+
+	v, err := m.Mount(ctx, depth, v)
+	if err != nil {
+		return nil, err
+	}
+
+	if node == nil {
+		parent.appendChild(v)
+	} else {
+		parent.replaceChild(v, node)
+	}
+	return v, nil
+}
+
+// hydrationChildren returns the child nodes of the given node that are
+// candidates for hydration. Comments and whitespace-only texts, which result
+// from the indentation of the server encoding, are ignored.
+func hydrationChildren(node Value) []Value {
+	childNodes := node.Get("childNodes")
+	if !childNodes.Truthy() {
+		return nil
+	}
+
+	children := make([]Value, 0, childNodes.Length())
+	for i := 0; i < childNodes.Length(); i++ {
+		child := childNodes.Index(i)
+
+		switch domNodeType(child) {
+		case commentNode:
+			continue
+
+		case textNode:
+			if strings.TrimSpace(child.Get("nodeValue").String()) == "" {
+				continue
+			}
+		}
+
+		children = append(children, child)
+	}
+	return children
+}
+
+// splitHydrationText splits the given text node, made of adjacent texts merged
+// by the browser, after the given value. It returns the node that contains the
+// remaining text, or nil when there is nothing to split.
+func splitHydrationText(node Value, v string) Value {
+	nodeValue := node.Get("nodeValue").String()
+	trimmed := strings.TrimLeftFunc(nodeValue, unicode.IsSpace)
+	v = strings.TrimLeftFunc(v, unicode.IsSpace)
+	if !strings.HasPrefix(trimmed, v) {
+		return nil
+	}
+
+	offset := len(nodeValue) - len(trimmed) + len(v)
+	if strings.TrimSpace(nodeValue[offset:]) == "" {
+		return nil
+	}
+
+	// DOM text offsets are expressed in UTF-16 code units.
+	return node.Call("splitText", len(utf16.Encode([]rune(nodeValue[:offset]))))
+}
+
+func domNodeType(v Value) int {
+	if v == nil || !v.Truthy() {
+		return 0
+	}
+	return v.Get("nodeType").Int()
+}
+
+func wrapperOrNil(v Value) Wrapper {
+	if v == nil {
+		return nil
+	}
+	return v
+}
+
+func logHydrationMismatch(v UI, depth uint, node Value) {
+	err := errors.New("hydration mismatch").
+		WithTag("depth", depth)
+
+	switch v := v.(type) {
+	case nil:
+		err = err.WithTag("expected", "nothing")
+
+	case HTML:
+		err = err.WithTag("expected", v.Tag())
+
+	case *text:
+		err = err.WithTag("expected", "text").
+			WithTag("preview-value", previewText(v.value))
+
+	default:
+		err = err.WithTag("expected", reflect.TypeOf(v))
+	}
+
+	switch domNodeType(node) {
+	case 0:
+		err = err.WithTag("found", "nothing")
+
+	case textNode:
+		err = err.WithTag("found", "text").
+			WithTag("preview-node-value", previewText(node.Get("nodeValue").String()))
+
+	default:
+		err = err.WithTag("found", strings.ToLower(node.Get("nodeName").String()))
+	}
+
+	Log(err)
+}
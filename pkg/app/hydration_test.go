@@ -0,0 +1,398 @@
+//go:build !wasm
+// +build !wasm
+
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNode is a minimal in-memory DOM node used to test hydration outside of
+// a browser.
+type fakeNode struct {
+	value
+
+	nodeType int
+	name     string
+	text     string
+	children []*fakeNode
+	parent   *fakeNode
+	mounted  bool
+}
+
+func fakeElem(name string, children ...*fakeNode) *fakeNode {
+	n := &fakeNode{
+		nodeType: elementNode,
+		name:     name,
+		children: children,
+	}
+	for _, c := range children {
+		c.parent = n
+	}
+	return n
+}
+
+func fakeText(v string) *fakeNode {
+	return &fakeNode{
+		nodeType: textNode,
+		text:     v,
+	}
+}
+
+func fakeComment(v string) *fakeNode {
+	return &fakeNode{
+		nodeType: commentNode,
+		text:     v,
+	}
+}
+
+func (n *fakeNode) Get(p string) Value {
+	switch p {
+	case "nodeType":
+		return fakeValue{v: n.nodeType}
+
+	case "nodeName":
+		return fakeValue{v: strings.ToUpper(n.name)}
+
+	case "nodeValue":
+		return fakeValue{v: n.text}
+
+	case "childNodes":
+		return fakeNodeList{nodes: n.children}
+
+	default:
+		return value{}
+	}
+}
+
+func (n *fakeNode) Call(m string, args ...any) Value {
+	if m != "splitText" {
+		return value{}
+	}
+
+	offset := args[0].(int)
+	rest := fakeText(n.text[offset:])
+	rest.parent = n.parent
+	n.text = n.text[:offset]
+
+	siblings := n.parent.children
+	for i, c := range siblings {
+		if c == n {
+			n.parent.children = append(siblings[:i+1], append([]*fakeNode{rest}, siblings[i+1:]...)...)
+			break
+		}
+	}
+	return rest
+}
+
+func (n *fakeNode) Truthy() bool {
+	return n != nil
+}
+
+func (n *fakeNode) JSValue() Value {
+	return n
+}
+
+func (n *fakeNode) setNodeValue(v string) {
+	n.text = v
+}
+
+func (n *fakeNode) appendChild(c Wrapper) {
+	n.children = append(n.children, toFakeNode(c))
+}
+
+func (n *fakeNode) insertBefore(new, ref Wrapper) {
+	if ref == nil {
+		n.appendChild(new)
+		return
+	}
+
+	for i, c := range n.children {
+		if c == ref.JSValue() {
+			n.children = append(n.children[:i], append([]*fakeNode{toFakeNode(new)}, n.children[i:]...)...)
+			return
+		}
+	}
+}
+
+func (n *fakeNode) replaceChild(new, old Wrapper) {
+	for i, c := range n.children {
+		if c == old.JSValue() {
+			n.children[i] = toFakeNode(new)
+			return
+		}
+	}
+}
+
+func (n *fakeNode) removeChild(c Wrapper) {
+	for i, child := range n.children {
+		if child == c.JSValue() {
+			n.children = append(n.children[:i], n.children[i+1:]...)
+			return
+		}
+	}
+}
+
+func toFakeNode(v Wrapper) *fakeNode {
+	if n, ok := v.JSValue().(*fakeNode); ok {
+		return n
+	}
+
+	switch v := v.(type) {
+	case *text:
+		return &fakeNode{nodeType: textNode, text: v.value, mounted: true}
+
+	case HTML:
+		return &fakeNode{nodeType: elementNode, name: v.Tag(), mounted: true}
+
+	case Composer:
+		return toFakeNode(v.root())
+
+	default:
+		return &fakeNode{mounted: true}
+	}
+}
+
+type fakeValue struct {
+	value
+	v any
+}
+
+func (v fakeValue) Int() int {
+	i, _ := v.v.(int)
+	return i
+}
+
+func (v fakeValue) String() string {
+	s, _ := v.v.(string)
+	return s
+}
+
+func (v fakeValue) Truthy() bool {
+	return v.v != nil
+}
+
+type fakeNodeList struct {
+	value
+	nodes []*fakeNode
+}
+
+func (l fakeNodeList) Length() int {
+	return len(l.nodes)
+}
+
+func (l fakeNodeList) Index(i int) Value {
+	return l.nodes[i]
+}
+
+func (l fakeNodeList) Truthy() bool {
+	return true
+}
+
+type portalRootCompo struct {
+	Compo
+}
+
+func (c *portalRootCompo) Render() UI {
+	return Portal("", Span().Text("modal"))
+}
+
+func TestNodeManagerHydrate(t *testing.T) {
+	var mismatches []string
+	defer func(logger func(string, ...any)) {
+		DefaultLogger = logger
+	}(DefaultLogger)
+	DefaultLogger = func(format string, v ...any) {
+		for _, a := range v {
+			if err, ok := a.(error); ok {
+				mismatches = append(mismatches, err.Error())
+			}
+		}
+	}
+
+	hydrate := func(t *testing.T, v UI, node *fakeNode) (UI, *fakeNode) {
+		mismatches = nil
+		parent := fakeElem("body", node)
+
+		hydrated, err := nodeManager{}.Hydrate(makeTestContext(), 1, v, parent, node)
+		require.NoError(t, err)
+		require.True(t, hydrated.Mounted())
+		return hydrated, parent
+	}
+
+	t.Run("matching markup is reused", func(t *testing.T) {
+		node := fakeElem("div",
+			fakeText("\n  "),
+			fakeElem("h1", fakeText("hello")),
+			fakeText("\n  "),
+			fakeComment("comment"),
+			fakeElem("p", fakeText("\n    world\n  ")),
+			fakeText("\n"),
+		)
+		div := Div().Body(
+			H1().Text("hello"),
+			P().Text("world"),
+		)
+
+		hydrated, parent := hydrate(t, div, node)
+		require.Empty(t, mismatches)
+		require.Same(t, node, parent.children[0])
+		require.Same(t, node, hydrated.JSValue())
+
+		h1 := div.body()[0]
+		require.Same(t, node.children[1], h1.JSValue())
+		require.Same(t, node.children[1].children[0], h1.(HTML).body()[0].JSValue())
+
+		p := div.body()[1]
+		require.Same(t, node.children[4], p.JSValue())
+		require.Equal(t, "world", node.children[4].children[0].text)
+		require.Same(t, div, p.parent())
+	})
+
+	t.Run("mismatching element is remounted", func(t *testing.T) {
+		node := fakeElem("div",
+			fakeElem("h1", fakeText("hello")),
+			fakeElem("p", fakeText("world")),
+		)
+		div := Div().Body(
+			H1().Text("hello"),
+			Span().Text("world"),
+		)
+
+		_, _ = hydrate(t, div, node)
+		require.Len(t, mismatches, 1)
+		require.Contains(t, mismatches[0], "hydration mismatch")
+
+		require.Len(t, node.children, 2)
+		require.False(t, node.children[0].mounted)
+		require.True(t, node.children[1].mounted)
+		require.Equal(t, "span", node.children[1].name)
+	})
+
+	t.Run("mismatching text is remounted", func(t *testing.T) {
+		node := fakeElem("p", fakeText("bye"))
+		p := P().Text("hello")
+
+		_, _ = hydrate(t, p, node)
+		require.Len(t, mismatches, 1)
+		require.True(t, node.children[0].mounted)
+		require.Equal(t, "hello", node.children[0].text)
+	})
+
+	t.Run("mismatching root is remounted", func(t *testing.T) {
+		node := fakeElem("div")
+		span := Span()
+
+		_, parent := hydrate(t, span, node)
+		require.Len(t, mismatches, 1)
+		require.True(t, parent.children[0].mounted)
+		require.Equal(t, "span", parent.children[0].name)
+	})
+
+	t.Run("missing nodes are mounted", func(t *testing.T) {
+		node := fakeElem("ul", fakeElem("li"))
+		ul := Ul().Body(Li(), Li())
+
+		_, _ = hydrate(t, ul, node)
+		require.Len(t, mismatches, 1)
+		require.Len(t, node.children, 2)
+		require.False(t, node.children[0].mounted)
+		require.True(t, node.children[1].mounted)
+	})
+
+	t.Run("extra nodes are removed", func(t *testing.T) {
+		node := fakeElem("ul", fakeElem("li"), fakeElem("li"), fakeText("extra"))
+		ul := Ul().Body(Li())
+
+		_, _ = hydrate(t, ul, node)
+		require.Len(t, mismatches, 2)
+		require.Len(t, node.children, 1)
+	})
+
+	t.Run("merged texts are split", func(t *testing.T) {
+		node := fakeElem("p", fakeText("\n  hello, \n  world\n"), fakeElem("b"))
+		p := P().Body(Text("hello, "), Text("world"), B())
+
+		_, _ = hydrate(t, p, node)
+		require.Empty(t, mismatches)
+		require.Len(t, node.children, 3)
+		require.False(t, node.children[0].mounted)
+		require.Equal(t, "hello, ", node.children[0].text)
+		require.False(t, node.children[1].mounted)
+		require.Equal(t, "world", node.children[1].text)
+		require.False(t, node.children[2].mounted)
+	})
+
+	t.Run("missing texts are mounted", func(t *testing.T) {
+		node := fakeElem("p", fakeElem("b"))
+		p := P().Body(Text(""), Text("hello"), B())
+
+		_, _ = hydrate(t, p, node)
+		require.Len(t, mismatches, 1)
+		require.Len(t, node.children, 3)
+		require.True(t, node.children[0].mounted)
+		require.True(t, node.children[1].mounted)
+		require.Equal(t, "hello", node.children[1].text)
+		require.False(t, node.children[2].mounted)
+	})
+
+	t.Run("component is hydrated", func(t *testing.T) {
+		node := fakeElem("div", fakeElem("h1", fakeText("hello, maxence")))
+		compo := &hello{Greeting: "maxence"}
+
+		hydrated, _ := hydrate(t, compo, node)
+		require.Empty(t, mismatches)
+		require.Same(t, compo, hydrated)
+		require.Same(t, node, compo.JSValue())
+		require.Same(t, compo, compo.root().parent())
+	})
+
+	t.Run("raw html is hydrated", func(t *testing.T) {
+		node := fakeElem("svg")
+		raw := Raw("<svg></svg>")
+
+		_, _ = hydrate(t, raw, node)
+		require.Empty(t, mismatches)
+		require.Same(t, node, raw.JSValue())
+	})
+
+	t.Run("portal is mounted without consuming nodes", func(t *testing.T) {
+		node := fakeElem("div", fakeElem("p", fakeText("hello")))
+		div := Div().Body(
+			Portal("", Span().Text("modal")),
+			P().Text("hello"),
+		)
+
+		_, _ = hydrate(t, div, node)
+		require.Empty(t, mismatches)
+		require.Len(t, node.children, 2)
+		require.True(t, div.body()[0].Mounted())
+		require.Same(t, node.children[1], div.body()[1].JSValue())
+	})
+
+	t.Run("portal root is mounted without consuming nodes", func(t *testing.T) {
+		node := fakeElem("div", fakeElem("p", fakeText("hello")))
+		div := Div().Body(
+			&portalRootCompo{},
+			P().Text("hello"),
+		)
+
+		_, _ = hydrate(t, div, node)
+		require.Empty(t, mismatches)
+		require.Len(t, node.children, 2)
+		require.True(t, div.body()[0].Mounted())
+		require.Same(t, node.children[1], div.body()[1].JSValue())
+	})
+
+	t.Run("mounted element is not hydrated", func(t *testing.T) {
+		div := Div()
+		_, err := nodeManager{}.Mount(makeTestContext(), 1, div)
+		require.NoError(t, err)
+
+		_, err = nodeManager{}.Hydrate(makeTestContext(), 1, div, fakeElem("body"), fakeElem("div"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/i18n"
+)
+
+// The name of the cookie that persists the language set with Context.SetLang.
+const langCookie = "goapp-lang"
+
+var (
+	catalog        *i18n.Catalog
+	defaultCatalog = i18n.NewCatalog("en")
+)
+
+// SetCatalog sets the catalog of the messages translated with Context.T and
+// Compo.T. When a catalog is set, the language of a page is negotiated between
+// the catalog languages and the ones preferred by the user, and falls back to
+// Handler.Lang when none of them matches.
+//
+// It must be called on both the server and the client, before the Handler
+// serves requests and before RunWhenOnBrowser:
+//
+//	func main() {
+//	    catalog := i18n.NewCatalog("en")
+//	    catalog.LoadJSON("fr", frenchMessages)
+//	    app.SetCatalog(catalog)
+//
+//	    app.Route("/", func() app.Composer { return &hello{} })
+//	    app.RunWhenOnBrowser()
+//	    ...
+//	}
+func SetCatalog(c *i18n.Catalog) {
+	catalog = c
+}
+
+func currentCatalog() *i18n.Catalog {
+	if catalog == nil {
+		return defaultCatalog
+	}
+	return catalog
+}
+
+// negotiateLang returns the catalog language that matches the persisted
+// language or, else, the preferred languages. It returns the given fallback
+// when none of them matches or when no catalog is set.
+func negotiateLang(persisted string, preferred []string, fallback string) string {
+	if catalog == nil {
+		return fallback
+	}
+
+	supported := catalog.Languages()
+	if lang, ok := i18n.Match(persisted, supported); ok {
+		return lang
+	}
+	return i18n.Negotiate(preferred, supported, fallback)
+}
+
+// requestLang returns the language of the page served for the given request.
+func requestLang(r *http.Request, fallback string) string {
+	var persisted string
+	if c, err := r.Cookie(langCookie); err == nil {
+		persisted, _ = url.QueryUnescape(c.Value)
+	}
+	return negotiateLang(
+		persisted,
+		i18n.ParseAcceptLanguage(r.Header.Get("Accept-Language")),
+		fallback,
+	)
+}
+
+// browserLang returns the language of the page loaded in the browser.
+func browserLang(fallback string) string {
+	var persisted string
+	cookies := Window().Get("document").Get("cookie").String()
+	for _, c := range strings.Split(cookies, ";") {
+		if name, value, _ := strings.Cut(strings.TrimSpace(c), "="); name == langCookie {
+			persisted, _ = url.QueryUnescape(value)
+		}
+	}
+
+	languages := Window().Get("navigator").Get("languages")
+	preferred := make([]string, languages.Length())
+	for i := range preferred {
+		preferred[i] = languages.Index(i).String()
+	}
+
+	return negotiateLang(persisted, preferred, fallback)
+}
+
+// persistLang stores the given language in a cookie that is sent with the
+// next page requests.
+func persistLang(lang string) {
+	Window().Get("document").Set("cookie", langCookie+"="+url.QueryEscape(lang)+
+		"; path=/; max-age=31536000; samesite=lax")
+}
+
+// langValue returns the value of the given language signal, or the catalog
+// fallback language when it is nil.
+func langValue(lang *Signal[string]) string {
+	if lang == nil {
+		return currentCatalog().Fallback()
+	}
+	return lang.Get()
+}
@@ -0,0 +1,187 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	Route("/translated", func() Composer { return &translatedTestCompo{} })
+}
+
+type translatedTestCompo struct {
+	Compo
+
+	renders int
+}
+
+func (c *translatedTestCompo) Render() UI {
+	c.renders++
+	return Div().Body(
+		H1().Text(c.T("hello", "Maxence")),
+		P().Text(c.TPlural("items", 2, 2)),
+	)
+}
+
+func setTestCatalog(t *testing.T) {
+	c := i18n.NewCatalog("en")
+	err := c.LoadJSON("en", []byte(`{
+		"hello": "Hello %s",
+		"items": {"one": "%d item", "other": "%d items"}
+	}`))
+	require.NoError(t, err)
+
+	err = c.LoadJSON("fr", []byte(`{
+		"hello": "Bonjour %s",
+		"items": {"one": "%d élément", "other": "%d éléments"}
+	}`))
+	require.NoError(t, err)
+
+	SetCatalog(c)
+	t.Cleanup(func() {
+		SetCatalog(nil)
+	})
+}
+
+func TestNegotiateLang(t *testing.T) {
+	t.Run("without catalog", func(t *testing.T) {
+		require.Equal(t, "de", negotiateLang("fr", []string{"fr"}, "de"))
+	})
+
+	t.Run("with catalog", func(t *testing.T) {
+		setTestCatalog(t)
+
+		utests := []struct {
+			scenario  string
+			persisted string
+			preferred []string
+			expected  string
+		}{
+			{
+				scenario:  "persisted language",
+				persisted: "fr",
+				preferred: []string{"en"},
+				expected:  "fr",
+			},
+			{
+				scenario:  "unsupported persisted language",
+				persisted: "de",
+				preferred: []string{"fr-CA", "en"},
+				expected:  "fr",
+			},
+			{
+				scenario:  "preferred language",
+				preferred: []string{"de", "en-US"},
+				expected:  "en",
+			},
+			{
+				scenario:  "fallback",
+				preferred: []string{"de"},
+				expected:  "ja",
+			},
+		}
+
+		for _, u := range utests {
+			t.Run(u.scenario, func(t *testing.T) {
+				require.Equal(t, u.expected, negotiateLang(u.persisted, u.preferred, "ja"))
+			})
+		}
+	})
+}
+
+func TestRequestLang(t *testing.T) {
+	setTestCatalog(t)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Language", "de, fr;q=0.8")
+	require.Equal(t, "fr", requestLang(r, "en"))
+
+	r.AddCookie(&http.Cookie{Name: langCookie, Value: "en"})
+	require.Equal(t, "en", requestLang(r, "en"))
+}
+
+func TestCompoTranslate(t *testing.T) {
+	t.Run("without catalog", func(t *testing.T) {
+		e := newTestEngine()
+		compo := &translatedTestCompo{}
+		require.NoError(t, e.Load(compo))
+		e.ConsumeAll()
+		require.NoError(t, Match(Text("hello"), compo, 0, 0, 0))
+		require.NoError(t, Match(Text("items"), compo, 0, 1, 0))
+	})
+
+	t.Run("set lang updates translating components", func(t *testing.T) {
+		setTestCatalog(t)
+
+		e := newTestEngine()
+		compo := &translatedTestCompo{}
+		require.NoError(t, e.Load(compo))
+		e.ConsumeAll()
+		require.NoError(t, Match(Text("Hello Maxence"), compo, 0, 0, 0))
+		require.NoError(t, Match(Text("2 items"), compo, 0, 1, 0))
+
+		renders := compo.renders
+		ctx := e.baseContext()
+		ctx.SetLang("fr")
+		e.ConsumeAll()
+		require.Equal(t, "fr", ctx.Lang())
+		require.Equal(t, "fr", ctx.Page().Lang())
+		require.Equal(t, renders+1, compo.renders)
+		require.NoError(t, Match(Text("Bonjour Maxence"), compo, 0, 0, 0))
+		require.NoError(t, Match(Text("2 éléments"), compo, 0, 1, 0))
+		require.Equal(t, "1,5", ctx.Locale().Number(1.5))
+	})
+}
+
+func TestHandlerServePageWithCatalog(t *testing.T) {
+	setTestCatalog(t)
+
+	r := httptest.NewRequest(http.MethodGet, "/translated", nil)
+	r.Header.Set("Accept-Language", "fr-FR, en;q=0.5")
+	w := httptest.NewRecorder()
+
+	h := Handler{}
+	h.ServeHTTP(w, r)
+
+	body := w.Body.String()
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "fr", w.Header().Get("Content-Language"))
+	require.Contains(t, body, `<html lang="fr">`)
+	require.Contains(t, body, `<h1>Bonjour Maxence</h1>`)
+	require.Contains(t, body, `<p>2 éléments</p>`)
+}
+
+func TestHandlerServePageWithCatalogAndCache(t *testing.T) {
+	setTestCatalog(t)
+
+	h := Handler{
+		PageCache: &PageCache{TTLs: map[string]time.Duration{
+			"/translated": time.Minute,
+		}},
+	}
+
+	serve := func(acceptLanguage string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/translated", nil)
+		r.Header.Set("Accept-Language", acceptLanguage)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "Accept-Language, Cookie", w.Header().Get("Vary"))
+		return w
+	}
+
+	fr := serve("fr-FR").Body.String()
+	require.Contains(t, fr, `<h1>Bonjour Maxence</h1>`)
+
+	en := serve("en-US").Body.String()
+	require.Contains(t, en, `<h1>Hello Maxence</h1>`)
+	require.NotEqual(t, fr, en)
+
+	require.Equal(t, fr, serve("fr-CA").Body.String())
+	require.Len(t, h.PageCache.keys["/translated"], 2)
+}
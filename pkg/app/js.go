@@ -126,11 +126,13 @@ type Value interface {
 	// value must be a promise.
 	Then(f func(Value))
 
+	release()
 	getAttr(k string) string
 	setAttr(k, v string)
 	delAttr(k string)
 	firstChild() Value
 	appendChild(c Wrapper)
+	insertBefore(new, ref Wrapper)
 	replaceChild(new, old Wrapper)
 	removeChild(c Wrapper)
 	firstElementChild() Value
@@ -139,6 +141,9 @@ type Value interface {
 	setNodeValue(v string)
 	setInnerHTML(v string)
 	setInnerText(v string)
+	transitionEnter(name string)
+	transitionLeave(c Wrapper, name string)
+	transitionMove(name string)
 }
 
 // Null returns the JavaScript value "null".
@@ -103,6 +103,9 @@ func (v value) Type() Type {
 func (v value) Then(f func(Value)) {
 }
 
+func (v value) release() {
+}
+
 func (v value) getAttr(k string) string {
 	return ""
 }
@@ -120,6 +123,9 @@ func (v value) firstChild() Value {
 func (v value) appendChild(c Wrapper) {
 }
 
+func (v value) insertBefore(new, ref Wrapper) {
+}
+
 func (v value) replaceChild(new, old Wrapper) {
 }
 
@@ -145,6 +151,15 @@ func (v value) setInnerHTML(val string) {
 func (v value) setInnerText(val string) {
 }
 
+func (v value) transitionEnter(name string) {
+}
+
+func (v value) transitionLeave(c Wrapper, name string) {
+}
+
+func (v value) transitionMove(name string) {
+}
+
 func null() Value {
 	return value{}
 }
@@ -198,6 +213,12 @@ func (w *browserWindow) setBody(body UI) {
 }
 
 func (w *browserWindow) createElement(tag, xmlns string) (Value, error) {
+	if !isValidTagName(tag) {
+		return nil, errors.New("creating javascript element failed").
+			WithTag("tag", tag).
+			WithTag("xmlns", xmlns).
+			WithTag("reason", "invalid tag name")
+	}
 	return value{}, nil
 }
 
@@ -211,6 +232,10 @@ func (w *browserWindow) addHistory(u *url.URL) {
 func (w *browserWindow) replaceHistory(u *url.URL) {
 }
 
+func flushDOMCommands() error {
+	return nil
+}
+
 func copyBytesToGo(dst []byte, src Value) int {
 	return 0
 }
@@ -218,3 +243,7 @@ func copyBytesToGo(dst []byte, src Value) int {
 func copyBytesToJS(dst Value, src []byte) int {
 	return 0
 }
+
+func cleanArg(v any) any {
+	return v
+}
@@ -9,7 +9,8 @@ import (
 )
 
 var (
-	window = &browserWindow{value: value{Value: js.Global()}}
+	window    = &browserWindow{value: value{Value: js.Global()}}
+	domBuffer domCommands
 )
 
 type value struct {
@@ -44,6 +45,13 @@ func (v value) Index(i int) Value {
 	return val(v.Value.Index(i))
 }
 
+func (v value) SetIndex(i int, x any) {
+	if wrapper, ok := x.(Wrapper); ok {
+		x = JSValue(wrapper.JSValue())
+	}
+	v.Value.SetIndex(i, x)
+}
+
 func (v value) InstanceOf(t Value) bool {
 	return v.Value.InstanceOf(JSValue(t))
 }
@@ -56,6 +64,10 @@ func (v value) JSValue() Value {
 	return v
 }
 
+func (v value) Length() int {
+	return v.Value.Length()
+}
+
 func (v value) New(args ...any) Value {
 	args = cleanArgs(args...)
 	return val(v.Value.New(args...))
@@ -83,16 +95,19 @@ func (v value) Then(f func(Value)) {
 	v.Call("then", then)
 }
 
+func (v value) release() {
+}
+
 func (v value) getAttr(k string) string {
 	return v.Call("getAttribute", k).String()
 }
 
 func (v value) setAttr(k, val string) {
-	v.Call("setAttribute", k, val)
+	domBuffer.Record(domSetAttr, domRef(v), k, val)
 }
 
 func (v value) delAttr(k string) {
-	v.Call("removeAttribute", k)
+	domBuffer.Record(domDelAttr, domRef(v), k)
 }
 
 func (v value) firstChild() Value {
@@ -100,15 +115,23 @@ func (v value) firstChild() Value {
 }
 
 func (v value) appendChild(c Wrapper) {
-	v.Call("appendChild", c)
+	domBuffer.Record(domAppendChild, domRef(v), domRef(c))
+}
+
+func (v value) insertBefore(new, ref Wrapper) {
+	if ref == nil {
+		v.appendChild(new)
+		return
+	}
+	domBuffer.Record(domInsertBefore, domRef(v), domRef(new), domRef(ref))
 }
 
 func (v value) replaceChild(new, old Wrapper) {
-	v.Call("replaceChild", new, old)
+	domBuffer.Record(domReplaceChild, domRef(v), domRef(new), domRef(old))
 }
 
 func (v value) removeChild(c Wrapper) {
-	v.Call("removeChild", c)
+	domBuffer.Record(domRemoveChild, domRef(v), domRef(c))
 }
 
 func (v value) firstElementChild() Value {
@@ -116,23 +139,275 @@ func (v value) firstElementChild() Value {
 }
 
 func (v value) addEventListener(event string, fn Func) {
-	v.Call("addEventListener", event, fn)
+	domBuffer.Record(domAddEventListener, domRef(v), event, domRef(fn))
 }
 
 func (v value) removeEventListener(event string, fn Func) {
-	v.Call("removeEventListener", event, fn)
+	domBuffer.Record(domRemoveEventListener, domRef(v), event, domRef(fn))
 }
 
 func (v value) setNodeValue(val string) {
-	v.Set("nodeValue", val)
+	domBuffer.Record(domSetNodeValue, domRef(v), val)
 }
 
 func (v value) setInnerHTML(val string) {
-	v.Set("innerHTML", val)
+	domBuffer.Record(domSetInnerHTML, domRef(v), val)
 }
 
 func (v value) setInnerText(val string) {
-	v.Set("innerText", val)
+	domBuffer.Record(domSetInnerText, domRef(v), val)
+}
+
+func (v value) transitionEnter(name string) {
+	domBuffer.Record(domTransitionEnter, domRef(v), name)
+}
+
+func (v value) transitionLeave(c Wrapper, name string) {
+	domBuffer.Record(domTransitionLeave, domRef(v), domRef(c), name)
+}
+
+func (v value) transitionMove(name string) {
+	domBuffer.Record(domTransitionMove, domRef(v), name)
+}
+
+// nodeRef is a DOM node created by a DOM command. It is referenced by its id
+// in the commands that follow, and its JavaScript value is only retrieved when
+// it is used outside of DOM commands.
+type nodeRef struct {
+	id       int
+	value    value
+	resolved bool
+}
+
+func newNodeRef() *nodeRef {
+	return &nodeRef{id: domBuffer.NewNode()}
+}
+
+// resolve applies the recorded DOM commands, so that reading the node reflects
+// them, and returns the JavaScript value of the node.
+func (n *nodeRef) resolve() value {
+	if err := flushDOMCommands(); err != nil {
+		Log(err)
+	}
+
+	if !n.resolved {
+		n.value = value{Value: js.Undefined()}
+		if getNode := js.Global().Get("goappDOMNode"); getNode.Truthy() {
+			n.value = value{Value: getNode.Invoke(n.id)}
+		}
+		n.resolved = true
+	}
+	return n.value
+}
+
+// release records that the node is no longer referenced by Go, which allows
+// app.js to forget it.
+func (n *nodeRef) release() {
+	domBuffer.Record(domReleaseNode, n.id)
+}
+
+func (n *nodeRef) Bool() bool {
+	return n.resolve().Bool()
+}
+
+func (n *nodeRef) Call(m string, args ...any) Value {
+	return n.resolve().Call(m, args...)
+}
+
+func (n *nodeRef) Delete(p string) {
+	n.resolve().Delete(p)
+}
+
+func (n *nodeRef) Equal(w Value) bool {
+	return n.resolve().Equal(w)
+}
+
+func (n *nodeRef) Float() float64 {
+	return n.resolve().Float()
+}
+
+func (n *nodeRef) Get(p string) Value {
+	return n.resolve().Get(p)
+}
+
+func (n *nodeRef) Index(i int) Value {
+	return n.resolve().Index(i)
+}
+
+func (n *nodeRef) InstanceOf(t Value) bool {
+	return n.resolve().InstanceOf(t)
+}
+
+func (n *nodeRef) Int() int {
+	return n.resolve().Int()
+}
+
+func (n *nodeRef) Invoke(args ...any) Value {
+	return n.resolve().Invoke(args...)
+}
+
+func (n *nodeRef) IsNaN() bool {
+	return false
+}
+
+func (n *nodeRef) IsNull() bool {
+	return false
+}
+
+func (n *nodeRef) IsUndefined() bool {
+	return false
+}
+
+func (n *nodeRef) JSValue() Value {
+	return n
+}
+
+func (n *nodeRef) Length() int {
+	return n.resolve().Length()
+}
+
+func (n *nodeRef) New(args ...any) Value {
+	return n.resolve().New(args...)
+}
+
+// Set records the property assignment as a DOM command when the given value
+// is a string, a boolean or an integer.
+func (n *nodeRef) Set(p string, x any) {
+	switch x.(type) {
+	case string, bool, int:
+		domBuffer.Record(domSetProperty, n.id, p, x)
+
+	default:
+		n.resolve().Set(p, x)
+	}
+}
+
+func (n *nodeRef) SetIndex(i int, x any) {
+	n.resolve().SetIndex(i, x)
+}
+
+func (n *nodeRef) String() string {
+	return n.resolve().String()
+}
+
+func (n *nodeRef) Truthy() bool {
+	return true
+}
+
+func (n *nodeRef) Type() Type {
+	return TypeObject
+}
+
+func (n *nodeRef) Then(f func(Value)) {
+	n.resolve().Then(f)
+}
+
+func (n *nodeRef) getAttr(k string) string {
+	return n.resolve().getAttr(k)
+}
+
+func (n *nodeRef) setAttr(k, v string) {
+	domBuffer.Record(domSetAttr, n.id, k, v)
+}
+
+func (n *nodeRef) delAttr(k string) {
+	domBuffer.Record(domDelAttr, n.id, k)
+}
+
+func (n *nodeRef) firstChild() Value {
+	return n.resolve().firstChild()
+}
+
+func (n *nodeRef) appendChild(c Wrapper) {
+	domBuffer.Record(domAppendChild, n.id, domRef(c))
+}
+
+func (n *nodeRef) insertBefore(new, ref Wrapper) {
+	if ref == nil {
+		n.appendChild(new)
+		return
+	}
+	domBuffer.Record(domInsertBefore, n.id, domRef(new), domRef(ref))
+}
+
+func (n *nodeRef) replaceChild(new, old Wrapper) {
+	domBuffer.Record(domReplaceChild, n.id, domRef(new), domRef(old))
+}
+
+func (n *nodeRef) removeChild(c Wrapper) {
+	domBuffer.Record(domRemoveChild, n.id, domRef(c))
+}
+
+func (n *nodeRef) firstElementChild() Value {
+	return n.resolve().firstElementChild()
+}
+
+func (n *nodeRef) addEventListener(event string, fn Func) {
+	domBuffer.Record(domAddEventListener, n.id, event, domRef(fn))
+}
+
+func (n *nodeRef) removeEventListener(event string, fn Func) {
+	domBuffer.Record(domRemoveEventListener, n.id, event, domRef(fn))
+}
+
+func (n *nodeRef) setNodeValue(v string) {
+	domBuffer.Record(domSetNodeValue, n.id, v)
+}
+
+func (n *nodeRef) setInnerHTML(v string) {
+	domBuffer.Record(domSetInnerHTML, n.id, v)
+}
+
+func (n *nodeRef) setInnerText(v string) {
+	domBuffer.Record(domSetInnerText, n.id, v)
+}
+
+func (n *nodeRef) transitionEnter(name string) {
+	domBuffer.Record(domTransitionEnter, n.id, name)
+}
+
+func (n *nodeRef) transitionLeave(c Wrapper, name string) {
+	domBuffer.Record(domTransitionLeave, n.id, domRef(c), name)
+}
+
+func (n *nodeRef) transitionMove(name string) {
+	domBuffer.Record(domTransitionMove, n.id, name)
+}
+
+// domRef returns the number that references the given value in DOM commands.
+func domRef(v Wrapper) int {
+	if n, ok := v.JSValue().(*nodeRef); ok {
+		return n.id
+	}
+	return domBuffer.Value(JSValue(v.JSValue()))
+}
+
+// flushDOMCommands applies the recorded DOM commands with a single JavaScript
+// call. Commands are discarded when app.js is not loaded, such as in tests.
+func flushDOMCommands() error {
+	commands, values, ok := domBuffer.Flush()
+	if !ok {
+		return nil
+	}
+
+	apply := js.Global().Get("goappApplyDOMCommands")
+	if !apply.Truthy() {
+		return nil
+	}
+
+	args := make([]any, 0, len(values)+1)
+	args = append(args, commands)
+	args = append(args, values...)
+
+	failures := apply.Invoke(args...)
+	if failures.Length() == 0 {
+		return nil
+	}
+	msgs := make([]string, failures.Length())
+	for i := range msgs {
+		msgs[i] = failures.Index(i).String()
+	}
+	return errors.New("applying dom commands failed").WithTag("errors", msgs)
 }
 
 func null() Value {
@@ -154,6 +429,9 @@ func valueOf(x any) Value {
 	case *browserWindow:
 		x = t.Value
 
+	case *nodeRef:
+		x = t.resolve().Value
+
 	case Event:
 		return valueOf(t.Value)
 	}
@@ -252,23 +530,22 @@ func (w *browserWindow) setBody(body UI) {
 }
 
 func (w *browserWindow) createElement(tag, xmlns string) (Value, error) {
-	var element Value
-	if xmlns == "" {
-		element = w.Get("document").Call("createElement", tag)
-	} else {
-		element = w.Get("document").Call("createElementNS", xmlns, tag)
-	}
-
-	if !element.Truthy() {
+	if !isValidTagName(tag) {
 		return nil, errors.New("creating javascript element failed").
 			WithTag("tag", tag).
-			WithTag("xmlns", xmlns)
+			WithTag("xmlns", xmlns).
+			WithTag("reason", "invalid tag name")
 	}
+
+	element := newNodeRef()
+	domBuffer.Record(domCreateElement, element.id, tag, xmlns)
 	return element, nil
 }
 
 func (w *browserWindow) createTextNode(v string) Value {
-	return w.Get("document").Call("createTextNode", v)
+	text := newNodeRef()
+	domBuffer.Record(domCreateTextNode, text.id, v)
+	return text
 }
 
 func (w *browserWindow) addHistory(u *url.URL) {
@@ -300,6 +577,9 @@ func JSValue(v Value) js.Value {
 	case *browserWindow:
 		return v.Value
 
+	case *nodeRef:
+		return v.resolve().Value
+
 	case Event:
 		return JSValue(v.Value)
 
@@ -341,6 +621,7 @@ func cleanArg(v any) any {
 		for i, val := range v {
 			s[i] = cleanArg(val)
 		}
+		return s
 
 	case function:
 		return v.fn
@@ -0,0 +1,172 @@
+package app
+
+import (
+	"strings"
+)
+
+// Layout is the interface that describes a component shared by the routes of
+// a route group. A layout renders the page matched by the current route at the
+// location of its outlet.
+//
+// When navigating between routes of the same group, the layout stays mounted
+// and only its outlet is updated, preserving the layout state such as scroll
+// positions or opened menus.
+//
+// Example:
+//
+//	type shell struct {
+//	    app.Compo
+//
+//	    Page app.UI
+//	}
+//
+//	func (s *shell) SetOutlet(v app.UI) {
+//	    s.Page = v
+//	}
+//
+//	func (s *shell) Render() app.UI {
+//	    return app.Div().Body(
+//	        app.Nav().Text("menu"),
+//	        app.Main().Body(s.Page),
+//	    )
+//	}
+type Layout interface {
+	Composer
+
+	// SetOutlet sets the element to render at the location of the layout
+	// outlet. The element is either the component of the matched route or the
+	// layout of a nested group.
+	//
+	// Storing the element in an exported field is recommended since the engine
+	// may reuse a mounted layout of the same type by copying its exported
+	// fields.
+	SetOutlet(UI)
+}
+
+// RouteGroup represents a set of routes that share a path prefix, a layout
+// and route guards.
+type RouteGroup struct {
+	router  *router
+	prefix  string
+	layouts []*routeLayout
+	guards  []RouteGuard
+}
+
+// Group creates a route group whose routes are prefixed with the given prefix
+// and rendered within the layout created by newLayout. An empty prefix leaves
+// the routes paths untouched.
+//
+// Example:
+//
+//	admin := app.Group("/admin", func() app.Layout {
+//	    return &adminShell{}
+//	})
+//	admin.Route("/", newDashboard)      // "/admin"
+//	admin.Route("/users", newUserList)  // "/admin/users"
+func Group(prefix string, newLayout func() Layout) RouteGroup {
+	return makeRouteGroup(&routes, prefix, newLayout)
+}
+
+func makeRouteGroup(r *router, prefix string, newLayout func() Layout) RouteGroup {
+	var layouts []*routeLayout
+	if newLayout != nil {
+		layouts = []*routeLayout{{newLayout: newLayout}}
+	}
+
+	return RouteGroup{
+		router:  r,
+		prefix:  strings.TrimRight(prefix, "/"),
+		layouts: layouts,
+	}
+}
+
+// Group creates a nested route group. Its routes are prefixed by both group
+// prefixes, protected by the guards of the current group and rendered within
+// the new layout, itself rendered within the layout of the current group.
+func (g RouteGroup) Group(prefix string, newLayout func() Layout) RouteGroup {
+	nested := makeRouteGroup(g.router, g.path(prefix), newLayout)
+	nested.layouts = append(g.layoutsCopy(), nested.layouts...)
+	nested.guards = g.guardsCopy()
+	return nested
+}
+
+// Guard returns a copy of the route group where the given guards are
+// evaluated, in order, before navigating to any of its routes. Guards of the
+// current group are evaluated first.
+//
+// Example:
+//
+//	admin := app.Group("/admin", nil).Guard(requireAuth)
+//	admin.Route("/users", newUserList)
+func (g RouteGroup) Guard(guards ...RouteGuard) RouteGroup {
+	g.guards = append(g.guardsCopy(), guards...)
+	return g
+}
+
+// Route associates the given path, prefixed by the group prefix, with a
+// function that generates a new Composer component rendered within the group
+// layout. The given guards are evaluated after the ones of the group. See
+// Route.
+func (g RouteGroup) Route(path string, newComponent func() Composer, guards ...RouteGuard) {
+	g.router.addRoute(g.path(path), g.target(newComponent, guards))
+}
+
+// RouteWithPattern associates the given URL path pattern, prefixed by the
+// group prefix, with a function that generates a new Composer component
+// rendered within the group layout. The given guards are evaluated after the
+// ones of the group. See RouteWithPattern.
+func (g RouteGroup) RouteWithPattern(pattern string, newComponent func() Composer, guards ...RouteGuard) {
+	g.router.addRouteWithPattern("", g.path(pattern), g.target(newComponent, guards))
+}
+
+// NamedRoute behaves like RouteWithPattern and additionally registers the
+// prefixed pattern under the given name. See NamedRoute.
+func (g RouteGroup) NamedRoute(name, pattern string, newComponent func() Composer, guards ...RouteGuard) {
+	g.router.addRouteWithPattern(name, g.path(pattern), g.target(newComponent, guards))
+}
+
+func (g RouteGroup) target(newComponent func() Composer, guards []RouteGuard) routeTarget {
+	return routeTarget{
+		newComponent: newComponent,
+		layouts:      g.layoutsCopy(),
+		guards:       append(g.guardsCopy(), guards...),
+	}
+}
+
+func (g RouteGroup) path(v string) string {
+	if v == "" || v == "/" {
+		if g.prefix == "" {
+			return "/"
+		}
+		return g.prefix
+	}
+
+	if !strings.HasPrefix(v, "/") {
+		v = "/" + v
+	}
+	return g.prefix + v
+}
+
+func (g RouteGroup) layoutsCopy() []*routeLayout {
+	layouts := make([]*routeLayout, len(g.layouts))
+	copy(layouts, g.layouts)
+	return layouts
+}
+
+func (g RouteGroup) guardsCopy() []RouteGuard {
+	guards := make([]RouteGuard, len(g.guards))
+	copy(guards, g.guards)
+	return guards
+}
+
+// routeLayout describes a layout of a route group. Its address identifies the
+// group, which is how the engine determines whether a mounted layout can be
+// kept when navigating.
+type routeLayout struct {
+	newLayout func() Layout
+}
+
+type mountedLayout struct {
+	definition *routeLayout
+	layout     Layout
+}
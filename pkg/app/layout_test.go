@@ -0,0 +1,167 @@
+package app
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type layoutCompo struct {
+	Compo
+
+	Page UI
+
+	dismounted bool
+}
+
+func (l *layoutCompo) SetOutlet(v UI) {
+	l.Page = v
+}
+
+func (l *layoutCompo) OnDismount() {
+	l.dismounted = true
+}
+
+func (l *layoutCompo) Render() UI {
+	return Div().Body(
+		Nav(),
+		Main().Body(l.Page),
+	)
+}
+
+type nestedLayoutCompo struct {
+	layoutCompo
+}
+
+func TestRouteGroup(t *testing.T) {
+	newLayout := func() Layout { return &layoutCompo{} }
+
+	t.Run("routes are prefixed", func(t *testing.T) {
+		r := makeRouter()
+		g := makeRouteGroup(&r, "/admin/", newLayout)
+		g.Route("/", NewZeroComponentFactory(&routeCompo{}))
+		g.Route("/users", NewZeroComponentFactory(&routeCompo{}))
+		g.RouteWithPattern("/users/{id}", NewZeroComponentFactory(&routeCompo{}))
+		g.NamedRoute("admin-post", "/posts/{id}", NewZeroComponentFactory(&routeCompo{}))
+
+		require.True(t, r.routed("/admin"))
+		require.True(t, r.routed("/admin/users"))
+		require.True(t, r.routed("/admin/users/42"))
+		require.False(t, r.routed("/users"))
+
+		u, err := r.url("admin-post", RouteParams{"id": "42"})
+		require.NoError(t, err)
+		require.Equal(t, "/admin/posts/42", u)
+	})
+
+	t.Run("routes without prefix are not modified", func(t *testing.T) {
+		r := makeRouter()
+		g := makeRouteGroup(&r, "", newLayout)
+		g.Route("/", NewZeroComponentFactory(&routeCompo{}))
+		g.Route("hello", NewZeroComponentFactory(&routeCompo{}))
+
+		require.True(t, r.routed("/"))
+		require.True(t, r.routed("/hello"))
+	})
+
+	t.Run("routes have group layouts", func(t *testing.T) {
+		r := makeRouter()
+		g := makeRouteGroup(&r, "/admin", newLayout)
+		nested := g.Group("/settings", newLayout)
+		g.Route("/", NewZeroComponentFactory(&routeCompo{}))
+		nested.Route("/profile", NewZeroComponentFactory(&routeCompo{}))
+
+		match, ok := r.match("/admin")
+		require.True(t, ok)
+		require.Len(t, match.layouts, 1)
+
+		nestedMatch, ok := r.match("/admin/settings/profile")
+		require.True(t, ok)
+		require.Len(t, nestedMatch.layouts, 2)
+		require.Same(t, match.layouts[0], nestedMatch.layouts[0])
+	})
+}
+
+func TestEngineNavigateWithLayouts(t *testing.T) {
+	navigate := func(e *engineX, path string) {
+		destination, _ := url.Parse(path)
+		e.Navigate(destination, false)
+	}
+
+	newEngine := func() *engineX {
+		e := newTestEngine()
+
+		g := makeRouteGroup(e.routes, "", func() Layout { return &layoutCompo{} })
+		g.Route("/hello", NewZeroComponentFactory(&hello{}))
+		g.Route("/bar", NewZeroComponentFactory(&bar{}))
+
+		nested := g.Group("/nested", func() Layout { return &nestedLayoutCompo{} })
+		nested.Route("/hello", NewZeroComponentFactory(&hello{}))
+		nested.Route("/bar", NewZeroComponentFactory(&bar{}))
+
+		e.routes.route("/foo", NewZeroComponentFactory(&foo{}))
+		return e
+	}
+
+	t.Run("page is rendered within the layout", func(t *testing.T) {
+		e := newEngine()
+		navigate(e, "/hello")
+
+		layout, ok := e.body.body()[0].(*layoutCompo)
+		require.True(t, ok)
+		require.True(t, layout.Mounted())
+		require.NoError(t, Match(&hello{}, layout, 0, 1, 0))
+	})
+
+	t.Run("layout is kept between sibling routes", func(t *testing.T) {
+		e := newEngine()
+		navigate(e, "/hello")
+		layout := e.body.body()[0].(*layoutCompo)
+		page := layout.Page.(*hello)
+
+		navigate(e, "/bar")
+		require.Same(t, layout, e.body.body()[0])
+		require.False(t, layout.dismounted)
+		require.False(t, page.Mounted())
+		require.NoError(t, Match(&bar{}, layout, 0, 1, 0))
+	})
+
+	t.Run("layout is dismounted when leaving the group", func(t *testing.T) {
+		e := newEngine()
+		navigate(e, "/hello")
+		layout := e.body.body()[0].(*layoutCompo)
+
+		navigate(e, "/foo")
+		require.IsType(t, &foo{}, e.body.body()[0])
+		require.True(t, layout.dismounted)
+		require.Empty(t, e.layouts)
+	})
+
+	t.Run("outer layout is kept when entering a nested group", func(t *testing.T) {
+		e := newEngine()
+		navigate(e, "/hello")
+		layout := e.body.body()[0].(*layoutCompo)
+
+		navigate(e, "/nested/hello")
+		require.Same(t, layout, e.body.body()[0])
+		require.False(t, layout.dismounted)
+		require.Len(t, e.layouts, 2)
+
+		nested, ok := layout.Page.(*nestedLayoutCompo)
+		require.True(t, ok)
+		require.True(t, nested.Mounted())
+		require.NoError(t, Match(&hello{}, nested, 0, 1, 0))
+
+		navigate(e, "/nested/bar")
+		require.Same(t, layout, e.body.body()[0])
+		require.Same(t, nested, layout.Page)
+		require.False(t, nested.dismounted)
+		require.NoError(t, Match(&bar{}, nested, 0, 1, 0))
+
+		navigate(e, "/bar")
+		require.Same(t, layout, e.body.body()[0])
+		require.True(t, nested.dismounted)
+		require.Len(t, e.layouts, 1)
+	})
+}
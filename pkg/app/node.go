@@ -5,6 +5,7 @@ import (
 	"html"
 	"io"
 	"reflect"
+	"sort"
 	"strconv"
 	"time"
 
@@ -118,6 +119,9 @@ func (m nodeManager) Mount(ctx Context, depth uint, v UI) (UI, error) {
 	case *raw:
 		return m.mountRawHTML(depth, v)
 
+	case *portal:
+		return m.mountPortal(ctx, depth, v)
+
 	default:
 		return nil, errors.New("unsupported element").
 			WithTag("type", reflect.TypeOf(v)).
@@ -145,16 +149,26 @@ func (m nodeManager) mountHTML(ctx Context, depth uint, v HTML) (UI, error) {
 			WithTag("depth", v.depth())
 	}
 
-	jsElement, _ := Window().createElement(v.Tag(), v.XMLNamespace())
+	jsElement, err := Window().createElement(v.Tag(), v.XMLNamespace())
+	if err != nil {
+		return nil, errors.New("mounting html element failed").
+			WithTag("type", reflect.TypeOf(v)).
+			WithTag("tag", v.Tag()).
+			WithTag("depth", depth).
+			Wrap(err)
+	}
 	v = v.setJSElement(jsElement)
 	m.mountHTMLAttributes(ctx, v)
+	m.mountHTMLProperties(v)
 	m.mountHTMLEventHandlers(ctx, v)
+	if name := v.transition(); name != "" {
+		v.JSValue().transitionEnter(name)
+	}
 
 	v = v.setDepth(depth).(HTML)
 	children := v.body()
 	for i, child := range children {
-		var err error
-		if child, err = m.Mount(ctx, depth+1, child); err != nil {
+		if child, err = m.Mount(ctx, depth+1, adopt(v, child)); err != nil {
 			return nil, errors.New("mounting child failed").
 				WithTag("type", reflect.TypeOf(v)).
 				WithTag("tag", v.Tag()).
@@ -172,7 +186,7 @@ func (m nodeManager) mountHTML(ctx Context, depth uint, v HTML) (UI, error) {
 
 func (m nodeManager) mountHTMLAttributes(ctx Context, v HTML) {
 	for name, value := range v.attrs() {
-		setJSAttribute(v.JSValue(), name, resolveAttributeURLValue(
+		setHTMLAttribute(v, name, resolveAttributeURLValue(
 			name,
 			value,
 			ctx.ResolveStaticResource,
@@ -180,6 +194,12 @@ func (m nodeManager) mountHTMLAttributes(ctx Context, v HTML) {
 	}
 }
 
+func (m nodeManager) mountHTMLProperties(v HTML) {
+	for name, value := range v.props() {
+		setJSProperty(v.JSValue(), name, value)
+	}
+}
+
 func (m nodeManager) mountHTMLEventHandlers(ctx Context, v HTML) {
 	events := v.events()
 	for event, handler := range events {
@@ -192,7 +212,7 @@ func (m nodeManager) mountHTMLEventHandler(ctx Context, v HTML, handler eventHan
 
 	jsHandler := FuncOf(func(this Value, args []Value) any {
 		if len(args) != 0 {
-			ctx.Dispatch(func(ctx Context) {
+			ctx.DispatchWithPriority(UserInputPriority, func(ctx Context) {
 				event := Event{Value: args[0]}
 				trackMousePosition(event)
 				handler.goHandler(ctx, event)
@@ -215,6 +235,14 @@ func (m nodeManager) mountHTMLEventHandler(ctx Context, v HTML, handler eventHan
 }
 
 func (m nodeManager) mountComponent(ctx Context, depth uint, v Composer) (UI, error) {
+	return m.mountComponentWith(ctx, depth, v, func(root UI) (UI, error) {
+		return m.Mount(ctx, depth+1, adopt(v, root))
+	})
+}
+
+// mountComponentWith mounts the given component, using mountRoot to mount its
+// rendered root.
+func (m nodeManager) mountComponentWith(ctx Context, depth uint, v Composer, mountRoot func(UI) (UI, error)) (UI, error) {
 	if v.Mounted() {
 		return nil, errors.New("component is already mounted").
 			WithTag("parent-type", reflect.TypeOf(v.parent())).
@@ -224,11 +252,20 @@ func (m nodeManager) mountComponent(ctx Context, depth uint, v Composer) (UI, er
 
 	v = v.setRef(v)
 	v = v.setDepth(depth)
+	v.setLang(ctx.lang)
 
 	if initializer, ok := v.(Initializer); ok {
 		initializer.OnInit()
 	}
 
+	if provider, ok := v.(Provider); ok {
+		provider.OnProvide(ctx)
+	}
+
+	if styler, ok := v.(Styler); ok && ctx.addStyles != nil {
+		ctx.addStyles(styler)
+	}
+
 	if preRenderer, ok := v.(PreRenderer); ok && IsServer {
 		ctx.Dispatch(preRenderer.OnPreRender)
 	}
@@ -237,6 +274,29 @@ func (m nodeManager) mountComponent(ctx Context, depth uint, v Composer) (UI, er
 		ctx.Dispatch(mounter.OnMount)
 	}
 
+	root, err := m.mountComponentRoot(v, mountRoot)
+	if err != nil {
+		fallback, ok := m.errorFallback(ctx, v, err)
+		if !ok {
+			v.setRef(nil)
+			return nil, err
+		}
+
+		if root, err = mountRoot(fallback); err != nil {
+			v.setRef(nil)
+			return nil, errors.New("mounting error boundary fallback failed").
+				WithTag("type", reflect.TypeOf(v)).
+				WithTag("depth", v.depth()).
+				Wrap(err)
+		}
+	}
+	root = root.setParent(v)
+	v = v.setRoot(root)
+
+	return v, nil
+}
+
+func (m nodeManager) mountComponentRoot(v Composer, mountRoot func(UI) (UI, error)) (UI, error) {
 	root, err := m.renderComponent(v)
 	if err != nil {
 		return nil, errors.New("rendering component failed").
@@ -244,24 +304,35 @@ func (m nodeManager) mountComponent(ctx Context, depth uint, v Composer) (UI, er
 			WithTag("depth", v.depth()).
 			Wrap(err)
 	}
-	if root, err = m.Mount(ctx, depth+1, root); err != nil {
+
+	mountedRoot, err := mountRoot(root)
+	if err != nil {
+		m.Dismount(root)
 		return nil, errors.New("mounting component root failed").
 			WithTag("type", reflect.TypeOf(v)).
 			WithTag("depth", v.depth()).
 			Wrap(err)
 	}
-	root = root.setParent(v)
-	v = v.setRoot(root)
-
-	return v, nil
+	return mountedRoot, nil
 }
 
-func (m nodeManager) renderComponent(v Composer) (UI, error) {
+func (m nodeManager) renderComponent(v Composer) (root UI, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError("render method panicked", r)
+		}
+	}()
+
 	rendering := FilterUIElems(v.Render())
 	if len(rendering) == 0 {
 		return nil, errors.New("render method does not returns a text, html element, or component")
 	}
-	return rendering[0], nil
+
+	root = rendering[0]
+	if styler, ok := v.(Styler); ok {
+		scopeElements(root, componentStyleOf(styler).scope)
+	}
+	return root, nil
 }
 
 func (m nodeManager) mountRawHTML(depth uint, v *raw) (UI, error) {
@@ -277,6 +348,7 @@ func (m nodeManager) mountRawHTML(depth uint, v *raw) (UI, error) {
 	wrapper.setInnerHTML(v.value)
 	v.jsElement = wrapper.firstChild()
 	wrapper.removeChild(v.jsElement)
+	wrapper.release()
 	return v, nil
 }
 
@@ -284,6 +356,9 @@ func (m nodeManager) mountRawHTML(depth uint, v *raw) (UI, error) {
 func (m nodeManager) Dismount(v UI) {
 	switch v := v.(type) {
 	case *text:
+		if v.Mounted() {
+			v.JSValue().release()
+		}
 
 	case HTML:
 		m.dismountHTML(v)
@@ -293,6 +368,9 @@ func (m nodeManager) Dismount(v UI) {
 
 	case *raw:
 		m.dismountRawHTML(v)
+
+	case *portal:
+		m.dismountPortal(v)
 	}
 }
 
@@ -305,6 +383,9 @@ func (m nodeManager) dismountHTML(v HTML) {
 		m.dismountHTMLEventHandler(handler)
 	}
 
+	if v.Mounted() {
+		v.JSValue().release()
+	}
 	v.setJSElement(nil)
 }
 
@@ -315,7 +396,12 @@ func (m nodeManager) dismountHTMLEventHandler(handler eventHandler) {
 }
 
 func (m nodeManager) dismountComponent(v Composer) {
+	if !v.Mounted() {
+		return
+	}
+
 	m.Dismount(v.root())
+	untrackComponentSignals(v)
 	v.setRef(nil)
 
 	if dismounter, ok := v.(Dismounter); ok {
@@ -340,7 +426,11 @@ func (m nodeManager) CanUpdate(v, new UI) bool {
 
 	switch v.(type) {
 	case *htmlElem, *htmlElemSelfClosing:
-		return v.(HTML).Tag() == new.(HTML).Tag()
+		return v.(HTML).Tag() == new.(HTML).Tag() &&
+			v.(HTML).XMLNamespace() == new.(HTML).XMLNamespace()
+
+	case *portal:
+		return v.(*portal).target == new.(*portal).target
 
 	default:
 		return true
@@ -369,6 +459,9 @@ func (m nodeManager) Update(ctx Context, v, new UI) (UI, error) {
 	case *raw:
 		return m.updateRawHTML(ctx, v, new.(*raw))
 
+	case *portal:
+		return m.updatePortal(ctx, v, new.(*portal))
+
 	default:
 		return nil, errors.New("unsupported element").WithTag("type", reflect.TypeOf(v))
 	}
@@ -394,6 +487,17 @@ func (m nodeManager) updateHTML(ctx Context, v, new HTML) (UI, error) {
 		m.updateHTMLAttributes(ctx, v, newAttrs)
 	}
 
+	props := v.props()
+	newProps := new.props()
+	if props == nil && len(newProps) != 0 {
+		v = v.setProps(newProps)
+		m.mountHTMLProperties(v)
+	} else if props != nil {
+		m.updateHTMLProperties(v, newProps)
+	}
+
+	v = v.setTransition(new.transition())
+
 	events := v.events()
 	newEvents := new.events()
 	if events == nil && len(newEvents) != 0 {
@@ -405,6 +509,14 @@ func (m nodeManager) updateHTML(ctx Context, v, new HTML) (UI, error) {
 
 	children := v.body()
 	newChildren := new.body()
+	if m.hasKeys(children) || m.hasKeys(newChildren) {
+		children, err := m.updateKeyedHTMLChildren(ctx, v, newChildren)
+		if err != nil {
+			return nil, err
+		}
+		return v.setBody(children), nil
+	}
+
 	sharedLen := min(len(children), len(newChildren))
 	for i := 0; i < min(len(children), len(newChildren)); i++ {
 		child := children[i]
@@ -423,7 +535,7 @@ func (m nodeManager) updateHTML(ctx Context, v, new HTML) (UI, error) {
 			continue
 		}
 
-		newChild, err := m.Mount(ctx, v.depth()+1, newChildren[i])
+		newChild, err := m.Mount(ctx, v.depth()+1, adopt(v, newChildren[i]))
 		if err != nil {
 			return nil, errors.New("mounting child failed").
 				WithTag("type", reflect.TypeOf(v)).
@@ -432,7 +544,7 @@ func (m nodeManager) updateHTML(ctx Context, v, new HTML) (UI, error) {
 				WithTag("index", i).
 				Wrap(err)
 		}
-		v.JSValue().replaceChild(newChild, child)
+		m.replaceHTMLChild(v.JSValue(), newChild, child)
 		newChild = newChild.setParent(v)
 		children[i] = newChild
 		m.Dismount(child)
@@ -440,14 +552,14 @@ func (m nodeManager) updateHTML(ctx Context, v, new HTML) (UI, error) {
 
 	for i := sharedLen; i < len(children); i++ {
 		child := children[i]
-		v.JSValue().removeChild(child)
+		m.removeHTMLChild(v.JSValue(), child)
 		m.Dismount(child)
 		children[i] = nil
 	}
 	children = children[:sharedLen]
 
 	for i := sharedLen; i < len(newChildren); i++ {
-		newChild, err := m.Mount(ctx, v.depth()+1, newChildren[i])
+		newChild, err := m.Mount(ctx, v.depth()+1, adopt(v, newChildren[i]))
 		if err != nil {
 			return nil, errors.New("mounting child failed").
 				WithTag("type", reflect.TypeOf(v)).
@@ -465,6 +577,152 @@ func (m nodeManager) updateHTML(ctx Context, v, new HTML) (UI, error) {
 	return v, nil
 }
 
+func (m nodeManager) hasKeys(v []UI) bool {
+	for _, child := range v {
+		if m.key(child) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (m nodeManager) key(v UI) string {
+	switch v := v.(type) {
+	case HTML:
+		return v.key()
+
+	case Keyer:
+		return toString(v.Key())
+
+	default:
+		return ""
+	}
+}
+
+// updateKeyedHTMLChildren reconciles the children of the given HTML element
+// with the new ones by matching keyed children by key and unkeyed children by
+// order. Matched children are updated and moved with insertBefore, so only the
+// children that actually appear or disappear are mounted or dismounted.
+func (m nodeManager) updateKeyedHTMLChildren(ctx Context, v HTML, newChildren []UI) ([]UI, error) {
+	children := v.body()
+
+	keyedIndexes := make(map[string]int, len(children))
+	var unkeyedIndexes []int
+	for i, child := range children {
+		if key := m.key(child); key != "" {
+			keyedIndexes[key] = i
+		} else {
+			unkeyedIndexes = append(unkeyedIndexes, i)
+		}
+	}
+
+	previousIndexes := make([]int, len(newChildren))
+	reused := make([]bool, len(children))
+	for i, newChild := range newChildren {
+		previousIndexes[i] = -1
+
+		previousIndex := -1
+		if key := m.key(newChild); key != "" {
+			if index, ok := keyedIndexes[key]; ok {
+				previousIndex = index
+				delete(keyedIndexes, key)
+			}
+		} else if len(unkeyedIndexes) != 0 {
+			previousIndex = unkeyedIndexes[0]
+			unkeyedIndexes = unkeyedIndexes[1:]
+		}
+
+		if previousIndex >= 0 && m.CanUpdate(children[previousIndex], newChild) {
+			previousIndexes[i] = previousIndex
+			reused[previousIndex] = true
+		}
+	}
+
+	// Move transitions are recorded before any child is removed or updated,
+	// so they start from the positions the children had before this update.
+	for i, child := range children {
+		if !reused[i] {
+			continue
+		}
+		if name := transition(child); name != "" {
+			child.JSValue().transitionMove(name)
+		}
+	}
+
+	updatedChildren := make([]UI, len(newChildren))
+	for i, newChild := range newChildren {
+		if previousIndex := previousIndexes[i]; previousIndex >= 0 {
+			child, err := m.Update(ctx, children[previousIndex], newChild)
+			if err != nil {
+				return nil, errors.New("updating child failed").
+					WithTag("type", reflect.TypeOf(v)).
+					WithTag("tag", v.Tag()).
+					WithTag("depth", v.depth()).
+					WithTag("index", i).
+					WithTag("key", m.key(newChild)).
+					Wrap(err)
+			}
+			updatedChildren[i] = child.setParent(v)
+			continue
+		}
+
+		child, err := m.Mount(ctx, v.depth()+1, adopt(v, newChild))
+		if err != nil {
+			return nil, errors.New("mounting child failed").
+				WithTag("type", reflect.TypeOf(v)).
+				WithTag("tag", v.Tag()).
+				WithTag("depth", v.depth()).
+				WithTag("index", i).
+				WithTag("key", m.key(newChild)).
+				Wrap(err)
+		}
+		updatedChildren[i] = child.setParent(v)
+	}
+
+	for i, child := range children {
+		if !reused[i] {
+			m.removeHTMLChild(v.JSValue(), child)
+			m.Dismount(child)
+		}
+		children[i] = nil
+	}
+
+	stable := longestIncreasingSubsequence(previousIndexes)
+	var next Wrapper
+	for i := len(updatedChildren) - 1; i >= 0; i-- {
+		child := updatedChildren[i]
+		if !stable[i] {
+			v.JSValue().insertBefore(child, next)
+		}
+		next = child
+	}
+
+	return updatedChildren, nil
+}
+
+// removeHTMLChild removes the given child from the given JavaScript element.
+// When the child has a transition, it is removed once its leave transition is
+// finished.
+func (m nodeManager) removeHTMLChild(v Value, child UI) {
+	if name := transition(child); name != "" {
+		v.transitionLeave(child, name)
+		return
+	}
+	v.removeChild(child)
+}
+
+// replaceHTMLChild replaces the given child of the given JavaScript element by
+// the given new child. When the old child has a transition, the new child is
+// inserted before it while it leaves.
+func (m nodeManager) replaceHTMLChild(v Value, new, old UI) {
+	if name := transition(old); name != "" {
+		v.insertBefore(new, old)
+		v.transitionLeave(old, name)
+		return
+	}
+	v.replaceChild(new, old)
+}
+
 func (m nodeManager) updateHTMLAttributes(ctx Context, v HTML, newAttrs attributes) {
 	attrs := v.attrs()
 	for name := range attrs {
@@ -480,7 +738,7 @@ func (m nodeManager) updateHTMLAttributes(ctx Context, v HTML, newAttrs attribut
 		}
 
 		attrs[name] = value
-		setJSAttribute(v.JSValue(), name, resolveAttributeURLValue(
+		setHTMLAttribute(v, name, resolveAttributeURLValue(
 			name,
 			value,
 			ctx.ResolveStaticResource,
@@ -488,6 +746,25 @@ func (m nodeManager) updateHTMLAttributes(ctx Context, v HTML, newAttrs attribut
 	}
 }
 
+func (m nodeManager) updateHTMLProperties(v HTML, newProps properties) {
+	props := v.props()
+	for name := range props {
+		if _, remains := newProps[name]; !remains {
+			deleteJSProperty(v.JSValue(), name)
+			delete(props, name)
+		}
+	}
+
+	for name, value := range newProps {
+		if current, ok := props[name]; ok && reflect.DeepEqual(current, value) {
+			continue
+		}
+
+		props[name] = value
+		setJSProperty(v.JSValue(), name, value)
+	}
+}
+
 func (m nodeManager) updateHTMLEventHandlers(ctx Context, v HTML, newEvents eventHandlers) {
 	events := v.events()
 	for event, handler := range events {
@@ -514,10 +791,17 @@ func (m nodeManager) updateHTMLEventHandlers(ctx Context, v HTML, newEvents even
 }
 
 func (m nodeManager) updateComponent(ctx Context, v, new Composer) (UI, error) {
+	var modifiedFields bool
+	if memoizer, ok := v.(Memoizer); ok {
+		if !memoizer.ShouldUpdate(new) {
+			return v, nil
+		}
+		modifiedFields = true
+	}
+
 	value := reflect.Indirect(reflect.ValueOf(v))
 	newValue := reflect.Indirect(reflect.ValueOf(new))
 
-	var modifiedFields bool
 	for i := 0; i < value.NumField(); i++ {
 		field := value.Field(i)
 		newField := newValue.Field(i)
@@ -545,10 +829,24 @@ func (m nodeManager) updateComponent(ctx Context, v, new Composer) (UI, error) {
 	return m.UpdateComponentRoot(ctx, v)
 }
 
-// UpdateComponentRoot updates the root element of the given component.
+// UpdateComponentRoot updates the root element of the given component. When
+// the update fails and the component is an error boundary, its root is
+// replaced by its error fallback.
 func (m nodeManager) UpdateComponentRoot(ctx Context, v Composer) (UI, error) {
 	ctx = m.context(ctx, v)
 
+	if _, err := m.updateComponentRoot(ctx, v); err != nil {
+		if boundary, ok := v.(ErrorBoundary); ok {
+			err = m.catchError(ctx, boundary, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func (m nodeManager) updateComponentRoot(ctx Context, v Composer) (UI, error) {
 	root := v.root()
 	newRoot, err := m.renderComponent(v)
 	if err != nil {
@@ -566,28 +864,37 @@ func (m nodeManager) UpdateComponentRoot(ctx Context, v Composer) (UI, error) {
 				Wrap(err)
 		}
 		v.setRoot(root)
-	} else {
-		if newRoot, err = m.Mount(ctx, v.depth()+1, newRoot); err != nil {
-			return nil, errors.New("mounting component root failed").
-				WithTag("type", reflect.TypeOf(v)).
-				WithTag("depth", v.depth()).
-				Wrap(err)
-		}
-
-		for parent := v.parent(); parent != nil; parent = parent.parent() {
-			if parent, isHTML := parent.(HTML); isHTML {
-				parent.JSValue().replaceChild(newRoot, root)
-				break
-			}
-		}
-		newRoot.setParent(v)
-		v.setRoot(newRoot)
-		m.Dismount(root)
+	} else if err := m.replaceComponentRoot(ctx, v, newRoot); err != nil {
+		return nil, errors.New("mounting component root failed").
+			WithTag("type", reflect.TypeOf(v)).
+			WithTag("depth", v.depth()).
+			Wrap(err)
 	}
 
 	return v, nil
 }
 
+// replaceComponentRoot mounts the given root and puts it in place of the
+// current root of the given component, which is then dismounted.
+func (m nodeManager) replaceComponentRoot(ctx Context, v Composer, newRoot UI) error {
+	root := v.root()
+	newRoot, err := m.Mount(ctx, v.depth()+1, adopt(v, newRoot))
+	if err != nil {
+		return err
+	}
+
+	for parent := v.parent(); parent != nil; parent = parent.parent() {
+		if parent, isHTML := parent.(HTML); isHTML {
+			m.replaceHTMLChild(parent.JSValue(), newRoot, root)
+			break
+		}
+	}
+	newRoot.setParent(v)
+	v.setRoot(newRoot)
+	m.Dismount(root)
+	return nil
+}
+
 func (m nodeManager) updateRawHTML(ctx Context, v, new *raw) (UI, error) {
 	if v.value == new.value {
 		return v, nil
@@ -612,9 +919,20 @@ func (m nodeManager) updateRawHTML(ctx Context, v, new *raw) (UI, error) {
 	return newMount, nil
 }
 
+// adopt sets the parent of the given element when it is not mounted, and
+// returns the element. It is called before an element is mounted so the
+// components it renders can look up the values provided by their ancestors.
+func adopt(parent, v UI) UI {
+	if !v.Mounted() {
+		v.setParent(parent)
+	}
+	return v
+}
+
 func (m nodeManager) context(ctx Context, v UI) Context {
 	ctx.sourceElement = v
 	ctx.notifyComponentEvent = m.NotifyComponentEvent
+	ctx.catchError = m.CatchError
 	return ctx
 }
 
@@ -630,6 +948,9 @@ func (m nodeManager) NotifyComponentEvent(ctx Context, root UI, event any) {
 			m.NotifyComponentEvent(ctx, child, event)
 		}
 
+	case *portal:
+		m.NotifyComponentEvent(ctx, element.container, event)
+
 	case Composer:
 		switch event.(type) {
 		case nav:
@@ -656,14 +977,22 @@ func (m nodeManager) NotifyComponentEvent(ctx Context, root UI, event any) {
 	}
 }
 
+// encodeWriter is the interface that describes the destination of encoded UI
+// elements. It is implemented by both *bytes.Buffer and *bufio.Writer.
+type encodeWriter interface {
+	io.Writer
+	io.ByteWriter
+	io.StringWriter
+}
+
 // Encode transforms the provided UI element into its HTML byte slice
 // representation. This allows for the conversion of in-memory UI structures
 // into a format suitable for server rendering.
-func (m nodeManager) Encode(ctx Context, w *bytes.Buffer, v UI) {
+func (m nodeManager) Encode(ctx Context, w encodeWriter, v UI) {
 	m.encode(ctx, w, 0, v)
 }
 
-func (m nodeManager) encode(ctx Context, w *bytes.Buffer, depth int, v UI) {
+func (m nodeManager) encode(ctx Context, w encodeWriter, depth int, v UI) {
 	switch v := v.(type) {
 	case *text:
 		m.encodeText(w, depth, v)
@@ -679,28 +1008,21 @@ func (m nodeManager) encode(ctx Context, w *bytes.Buffer, depth int, v UI) {
 	}
 }
 
-func (m nodeManager) encodeText(w *bytes.Buffer, depth int, v *text) {
+func (m nodeManager) encodeText(w encodeWriter, depth int, v *text) {
 	if v.value != "" {
 		m.encodeIndent(w, depth)
 		w.WriteString(html.EscapeString(v.value))
 	}
 }
 
-func (m nodeManager) encodeIndent(w *bytes.Buffer, depth int) {
+func (m nodeManager) encodeIndent(w encodeWriter, depth int) {
 	for i := 0; i < depth*2; i++ {
 		w.WriteByte(' ')
 	}
 }
 
-func (m nodeManager) encodeHTML(ctx Context, w *bytes.Buffer, depth int, v HTML) {
-	m.encodeIndent(w, depth)
-	w.WriteByte('<')
-	w.WriteString(v.Tag())
-	for name, value := range v.attrs() {
-		m.encodeHTMLAttribute(ctx, w, name, value)
-	}
-	w.WriteByte('>')
-
+func (m nodeManager) encodeHTML(ctx Context, w encodeWriter, depth int, v HTML) {
+	m.encodeHTMLOpeningTag(ctx, w, depth, v)
 	if v.SelfClosing() {
 		return
 	}
@@ -727,12 +1049,32 @@ func (m nodeManager) encodeHTML(ctx Context, w *bytes.Buffer, depth int, v HTML)
 		}
 	}
 
+	m.encodeHTMLClosingTag(w, v)
+}
+
+func (m nodeManager) encodeHTMLOpeningTag(ctx Context, w encodeWriter, depth int, v HTML) {
+	m.encodeIndent(w, depth)
+	w.WriteByte('<')
+	w.WriteString(v.Tag())
+	switch v.Tag() {
+	case "svg", "math":
+		if xmlns := v.XMLNamespace(); xmlns != "" {
+			m.encodeHTMLAttribute(ctx, w, "xmlns", xmlns, true)
+		}
+	}
+	for name, value := range v.attrs() {
+		m.encodeHTMLAttribute(ctx, w, name, value, v.XMLNamespace() != "")
+	}
+	w.WriteByte('>')
+}
+
+func (m nodeManager) encodeHTMLClosingTag(w encodeWriter, v HTML) {
 	w.WriteString("</")
 	w.WriteString(v.Tag())
 	w.WriteByte('>')
 }
 
-func (m nodeManager) encodeHTMLAttribute(ctx Context, w *bytes.Buffer, name, value string) {
+func (m nodeManager) encodeHTMLAttribute(ctx Context, w encodeWriter, name, value string, xml bool) {
 	if value == "" {
 		switch name {
 		case "id", "class", "title":
@@ -742,13 +1084,13 @@ func (m nodeManager) encodeHTMLAttribute(ctx Context, w *bytes.Buffer, name, val
 
 	w.WriteString(" ")
 	w.WriteString(name)
-	if value != "" && value != "true" {
+	if value != "" && (value != "true" || xml) {
 		w.WriteString("=")
 		w.WriteString(strconv.Quote(resolveAttributeURLValue(name, value, ctx.ResolveStaticResource)))
 	}
 }
 
-func (m nodeManager) encodeComponent(ctx Context, w *bytes.Buffer, depth int, v Composer) {
+func (m nodeManager) encodeComponent(ctx Context, w encodeWriter, depth int, v Composer) {
 	root := v.root()
 	if root == nil {
 		root, _ = m.renderComponent(v)
@@ -758,7 +1100,7 @@ func (m nodeManager) encodeComponent(ctx Context, w *bytes.Buffer, depth int, v
 	}
 }
 
-func (m nodeManager) encodeRawHTML(w *bytes.Buffer, depth int, v *raw) {
+func (m nodeManager) encodeRawHTML(w encodeWriter, depth int, v *raw) {
 	if v.value != "" {
 		m.encodeIndent(w, depth)
 		w.WriteString(v.value)
@@ -794,6 +1136,59 @@ func canUpdateValue(v, new reflect.Value) bool {
 	}
 }
 
+// longestIncreasingSubsequence returns a mask that reports which indexes of v
+// belong to one of its longest strictly increasing subsequences. Negative
+// values are ignored.
+func longestIncreasingSubsequence(v []int) []bool {
+	mask := make([]bool, len(v))
+	predecessors := make([]int, len(v))
+	var tails []int
+
+	for i, n := range v {
+		if n < 0 {
+			continue
+		}
+
+		j := sort.Search(len(tails), func(k int) bool {
+			return v[tails[k]] >= n
+		})
+		if j > 0 {
+			predecessors[i] = tails[j-1]
+		} else {
+			predecessors[i] = -1
+		}
+
+		if j == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[j] = i
+		}
+	}
+
+	if len(tails) == 0 {
+		return mask
+	}
+	for i := tails[len(tails)-1]; i >= 0; i = predecessors[i] {
+		mask[i] = true
+	}
+	return mask
+}
+
+// transition returns the transition name of the given element, or of the root of
+// the given component.
+func transition(v UI) string {
+	switch v := v.(type) {
+	case HTML:
+		return v.transition()
+
+	case Composer:
+		if root := v.root(); root != nil {
+			return transition(root)
+		}
+	}
+	return ""
+}
+
 func component(v UI) (Composer, bool) {
 	for element := v; element != nil; element = element.parent() {
 		if component, ok := element.(Composer); ok {
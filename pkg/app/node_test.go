@@ -195,6 +195,30 @@ func TestNodeManagerMount(t *testing.T) {
 		require.Equal(t, uint(1), div.(HTML).depth())
 	})
 
+	t.Run("mounting svg succeeds", func(t *testing.T) {
+		var m nodeManager
+
+		svg, err := m.Mount(ctx, 1, Svg().
+			ViewBox("0 0 24 24").
+			Body(
+				Circle().
+					Class("dot").
+					Cx(12).
+					Cy(12).
+					R(4),
+			))
+		require.NoError(t, err)
+		require.True(t, svg.Mounted())
+		require.Equal(t, svgNamespace, svg.(HTML).XMLNamespace())
+		require.Equal(t, "0 0 24 24", svg.(HTML).attrs()["viewBox"])
+
+		circle := svg.(HTML).body()[0].(HTML)
+		require.True(t, circle.Mounted())
+		require.Equal(t, svgNamespace, circle.XMLNamespace())
+		require.Equal(t, "12", circle.attrs()["cx"])
+		require.Equal(t, "dot", circle.attrs()["class"])
+	})
+
 	t.Run("mounting html body succeeds", func(t *testing.T) {
 		var m nodeManager
 
@@ -268,6 +292,15 @@ func TestNodeManagerMount(t *testing.T) {
 		t.Log(err)
 	})
 
+	t.Run("mounting html with an invalid tag returns an error", func(t *testing.T) {
+		var m nodeManager
+
+		elem, err := m.Mount(ctx, 1, Elem("my element"))
+		require.Error(t, err)
+		require.Nil(t, elem)
+		t.Log(err)
+	})
+
 	t.Run("mounting html with non mountable child returns an error", func(t *testing.T) {
 		var m nodeManager
 
@@ -461,6 +494,11 @@ func TestNodeManagerCanUpdate(t *testing.T) {
 		var m nodeManager
 		require.False(t, m.CanUpdate(ElemSelfClosing("input"), ElemSelfClosing("br")))
 	})
+
+	t.Run("generic html elements with different namespace cannot be updated", func(t *testing.T) {
+		var m nodeManager
+		require.False(t, m.CanUpdate(Elem("a"), Elem("a").XMLNS(svgNamespace)))
+	})
 }
 
 func BenchmarkNodeManagerCanUpdate(b *testing.B) {
@@ -549,6 +587,141 @@ func TestNodeManagerUpdate(t *testing.T) {
 		require.Empty(t, div.(HTML).attrs()["class"])
 	})
 
+	t.Run("update html adds a property", func(t *testing.T) {
+		var m nodeManager
+
+		elem, err := m.Mount(ctx, 1, Elem("x-list"))
+		require.NoError(t, err)
+		require.Empty(t, elem.(HTML).props())
+
+		elem, err = m.Update(ctx, elem, Elem("x-list").Prop("items", []any{"a", "b"}))
+		require.NoError(t, err)
+		require.Len(t, elem.(HTML).props(), 1)
+		require.Equal(t, []any{"a", "b"}, elem.(HTML).props()["items"])
+	})
+
+	t.Run("update html updates a property", func(t *testing.T) {
+		var m nodeManager
+
+		elem, err := m.Mount(ctx, 1, Elem("x-list").Prop("items", []any{"a"}))
+		require.NoError(t, err)
+		require.Equal(t, []any{"a"}, elem.(HTML).props()["items"])
+
+		elem, err = m.Update(ctx, elem, Elem("x-list").Prop("items", []any{"a", "b"}))
+		require.NoError(t, err)
+		require.Len(t, elem.(HTML).props(), 1)
+		require.Equal(t, []any{"a", "b"}, elem.(HTML).props()["items"])
+	})
+
+	t.Run("update html removes a property", func(t *testing.T) {
+		var m nodeManager
+
+		elem, err := m.Mount(ctx, 1, Elem("x-list").
+			Prop("items", []any{"a"}).
+			Prop("selected", 0))
+		require.NoError(t, err)
+		require.Len(t, elem.(HTML).props(), 2)
+
+		elem, err = m.Update(ctx, elem, Elem("x-list").Prop("selected", 0))
+		require.NoError(t, err)
+		require.Len(t, elem.(HTML).props(), 1)
+		require.NotContains(t, elem.(HTML).props(), "items")
+	})
+
+	t.Run("update html updates a transition", func(t *testing.T) {
+		var m nodeManager
+
+		div, err := m.Mount(ctx, 1, Div().Transition("fade"))
+		require.NoError(t, err)
+		require.Equal(t, "fade", div.(HTML).transition())
+
+		div, err = m.Update(ctx, div, Div().Transition("slide"))
+		require.NoError(t, err)
+		require.Equal(t, "slide", div.(HTML).transition())
+	})
+
+	t.Run("update html removes children with a transition", func(t *testing.T) {
+		var m nodeManager
+
+		div, err := m.Mount(ctx, 1, Ul().Body(
+			Li().Transition("fade").Text("a"),
+			Li().Transition("fade").Text("b"),
+		))
+		require.NoError(t, err)
+
+		var commands domCommands
+		recordDOM(&commands, div)
+
+		child := div.(HTML).body()[1]
+		div, err = m.Update(ctx, div, Ul().Body(
+			Li().Transition("fade").Text("a"),
+		))
+		require.NoError(t, err)
+		require.Len(t, div.(HTML).body(), 1)
+		require.False(t, child.Mounted())
+
+		recorded, _, _ := commands.Flush()
+		require.Equal(t, `[16,1,3,"fade"]`, recorded)
+	})
+
+	t.Run("update html replaces a condition with a transition", func(t *testing.T) {
+		var m nodeManager
+
+		render := func(show bool) UI {
+			return Div().Body(
+				If(show, func() UI {
+					return P().Transition("fade").Text("shown")
+				}).Else(func() UI {
+					return Span().Transition("fade").Text("hidden")
+				}),
+			)
+		}
+
+		div, err := m.Mount(ctx, 1, render(true))
+		require.NoError(t, err)
+
+		var commands domCommands
+		recordDOM(&commands, div)
+
+		p := div.(HTML).body()[0]
+		div, err = m.Update(ctx, div, render(false))
+		require.NoError(t, err)
+		require.False(t, p.Mounted())
+		span := div.(HTML).body()[0]
+		require.Equal(t, "span", span.(HTML).Tag())
+
+		recorded, values, _ := commands.Flush()
+		require.Equal(t, `[6,1,-1,2,16,1,2,"fade"]`, recorded)
+		require.Equal(t, []any{span}, values)
+	})
+
+	t.Run("update html moves keyed children with a transition", func(t *testing.T) {
+		var m nodeManager
+
+		render := func(keys ...string) UI {
+			items := make([]UI, len(keys))
+			for i, k := range keys {
+				items[i] = Li().Key(k).Transition("list").Text(k)
+			}
+			return Ul().Body(items...)
+		}
+
+		ul, err := m.Mount(ctx, 1, render("a", "b", "c"))
+		require.NoError(t, err)
+
+		var commands domCommands
+		recordDOM(&commands, ul)
+
+		a := ul.(HTML).body()[0]
+		ul, err = m.Update(ctx, ul, render("c", "a"))
+		require.NoError(t, err)
+		require.Len(t, ul.(HTML).body(), 2)
+		require.Same(t, a, ul.(HTML).body()[1])
+
+		recorded, _, _ := commands.Flush()
+		require.Equal(t, `[17,2,"list",17,4,"list",16,1,3,"list",6,1,4,2]`, recorded)
+	})
+
 	t.Run("update html adds an event handler", func(t *testing.T) {
 		var m nodeManager
 
@@ -762,6 +935,39 @@ func TestNodeManagerUpdate(t *testing.T) {
 		require.Equal(t, "bar", compo.(Composer).root().(*text).value)
 	})
 
+	t.Run("update memoized component with equal inputs skips update", func(t *testing.T) {
+		var m nodeManager
+
+		compo, err := m.Mount(ctx, 1, &memoCompo{
+			Value:   "bar",
+			OnClick: func() {},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, compo.(*memoCompo).renders)
+
+		onClick := func() {}
+		updatedCompo, err := m.Update(ctx, compo, &memoCompo{
+			Value:   "bar",
+			OnClick: onClick,
+		})
+		require.NoError(t, err)
+		require.Equal(t, compo, updatedCompo)
+		require.Equal(t, 1, compo.(*memoCompo).renders)
+	})
+
+	t.Run("update memoized component with different inputs", func(t *testing.T) {
+		var m nodeManager
+
+		compo, err := m.Mount(ctx, 1, &memoCompo{Value: "bar"})
+		require.NoError(t, err)
+
+		updatedCompo, err := m.Update(ctx, compo, &memoCompo{Value: "foo"})
+		require.NoError(t, err)
+		require.Equal(t, compo, updatedCompo)
+		require.Equal(t, 2, compo.(*memoCompo).renders)
+		require.Equal(t, "foo", compo.(Composer).root().(*text).value)
+	})
+
 	t.Run("update component with non renderable component returns an error", func(t *testing.T) {
 		var m nodeManager
 
@@ -867,6 +1073,278 @@ func TestNodeManagerUpdate(t *testing.T) {
 	})
 }
 
+func TestNodeManagerUpdateKeyed(t *testing.T) {
+	ctx := makeTestContext()
+
+	t.Run("keyed html children are moved", func(t *testing.T) {
+		var m nodeManager
+
+		ul, err := m.Mount(ctx, 1, Ul().Body(
+			Li().Key("a").Text("a"),
+			Li().Key("b").Text("b"),
+			Li().Key("c").Text("c"),
+		))
+		require.NoError(t, err)
+		a := ul.(HTML).body()[0]
+		b := ul.(HTML).body()[1]
+		c := ul.(HTML).body()[2]
+
+		ul, err = m.Update(ctx, ul, Ul().Body(
+			Li().Key("c").Text("c"),
+			Li().Key("a").Text("a"),
+			Li().Key("b").Text("b"),
+		))
+		require.NoError(t, err)
+		require.Len(t, ul.(HTML).body(), 3)
+		require.Same(t, c, ul.(HTML).body()[0])
+		require.Same(t, a, ul.(HTML).body()[1])
+		require.Same(t, b, ul.(HTML).body()[2])
+	})
+
+	t.Run("keyed html child is inserted at the top", func(t *testing.T) {
+		var m nodeManager
+
+		ul, err := m.Mount(ctx, 1, Ul().Body(
+			Li().Key(1).Text("1"),
+			Li().Key(2).Text("2"),
+		))
+		require.NoError(t, err)
+		one := ul.(HTML).body()[0]
+		two := ul.(HTML).body()[1]
+
+		ul, err = m.Update(ctx, ul, Ul().Body(
+			Li().Key(0).Text("0"),
+			Li().Key(1).Text("1"),
+			Li().Key(2).Text("2"),
+		))
+		require.NoError(t, err)
+		require.Len(t, ul.(HTML).body(), 3)
+		require.True(t, ul.(HTML).body()[0].Mounted())
+		require.Equal(t, "0", ul.(HTML).body()[0].(HTML).key())
+		require.Same(t, one, ul.(HTML).body()[1])
+		require.Same(t, two, ul.(HTML).body()[2])
+	})
+
+	t.Run("keyed html child is removed", func(t *testing.T) {
+		var m nodeManager
+
+		ul, err := m.Mount(ctx, 1, Ul().Body(
+			Li().Key("a"),
+			Li().Key("b"),
+			Li().Key("c"),
+		))
+		require.NoError(t, err)
+		a := ul.(HTML).body()[0]
+		b := ul.(HTML).body()[1]
+		c := ul.(HTML).body()[2]
+
+		ul, err = m.Update(ctx, ul, Ul().Body(
+			Li().Key("a"),
+			Li().Key("c"),
+		))
+		require.NoError(t, err)
+		require.Len(t, ul.(HTML).body(), 2)
+		require.Same(t, a, ul.(HTML).body()[0])
+		require.Same(t, c, ul.(HTML).body()[1])
+		require.False(t, b.Mounted())
+	})
+
+	t.Run("keyed html child with a different tag is replaced", func(t *testing.T) {
+		var m nodeManager
+
+		div, err := m.Mount(ctx, 1, Div().Body(
+			Span().Key("a"),
+		))
+		require.NoError(t, err)
+		span := div.(HTML).body()[0]
+
+		div, err = m.Update(ctx, div, Div().Body(
+			P().Key("a"),
+		))
+		require.NoError(t, err)
+		require.Len(t, div.(HTML).body(), 1)
+		require.IsType(t, P(), div.(HTML).body()[0])
+		require.True(t, div.(HTML).body()[0].Mounted())
+		require.False(t, span.Mounted())
+	})
+
+	t.Run("unkeyed html children are matched by order", func(t *testing.T) {
+		var m nodeManager
+
+		div, err := m.Mount(ctx, 1, Div().Body(
+			H1(),
+			Span().Key("a"),
+			P(),
+		))
+		require.NoError(t, err)
+		h1 := div.(HTML).body()[0]
+		span := div.(HTML).body()[1]
+		p := div.(HTML).body()[2]
+
+		div, err = m.Update(ctx, div, Div().Body(
+			Span().Key("a"),
+			H1(),
+			P(),
+		))
+		require.NoError(t, err)
+		require.Len(t, div.(HTML).body(), 3)
+		require.Same(t, span, div.(HTML).body()[0])
+		require.Same(t, h1, div.(HTML).body()[1])
+		require.Same(t, p, div.(HTML).body()[2])
+	})
+
+	t.Run("keyed components keep their state", func(t *testing.T) {
+		var m nodeManager
+
+		ul, err := m.Mount(ctx, 1, Ul().Body(
+			&keyedCompo{ID: 1, Label: "one"},
+			&keyedCompo{ID: 2, Label: "two"},
+			&keyedCompo{ID: 3, Label: "three"},
+		))
+		require.NoError(t, err)
+		one := ul.(HTML).body()[0].(*keyedCompo)
+		two := ul.(HTML).body()[1].(*keyedCompo)
+		three := ul.(HTML).body()[2].(*keyedCompo)
+
+		ul, err = m.Update(ctx, ul, Ul().Body(
+			&keyedCompo{ID: 3, Label: "three"},
+			&keyedCompo{ID: 1, Label: "uno"},
+		))
+		require.NoError(t, err)
+		require.Len(t, ul.(HTML).body(), 2)
+		require.Same(t, three, ul.(HTML).body()[0])
+		require.Same(t, one, ul.(HTML).body()[1])
+		require.Equal(t, "uno", one.root().(HTML).body()[0].(*text).value)
+		require.False(t, one.dismounted)
+		require.False(t, three.dismounted)
+		require.True(t, two.dismounted)
+	})
+
+	t.Run("keyed update with non mountable child returns an error", func(t *testing.T) {
+		var m nodeManager
+
+		div, err := m.Mount(ctx, 1, Div().Body(
+			Span().Key("a"),
+		))
+		require.NoError(t, err)
+
+		div, err = m.Update(ctx, div, Div().Body(
+			Span().Key("a"),
+			&compoWithNilRendering{},
+		))
+		require.Error(t, err)
+		require.Zero(t, div)
+		t.Log(err)
+	})
+}
+
+func TestLongestIncreasingSubsequence(t *testing.T) {
+	utests := []struct {
+		scenario string
+		in       []int
+		expected []bool
+	}{
+		{
+			scenario: "empty",
+			expected: []bool{},
+		},
+		{
+			scenario: "sorted",
+			in:       []int{0, 1, 2},
+			expected: []bool{true, true, true},
+		},
+		{
+			scenario: "last moved to the top",
+			in:       []int{2, 0, 1},
+			expected: []bool{false, true, true},
+		},
+		{
+			scenario: "new elements are ignored",
+			in:       []int{-1, 0, -1, 1},
+			expected: []bool{false, true, false, true},
+		},
+		{
+			scenario: "reversed",
+			in:       []int{2, 1, 0},
+			expected: []bool{false, false, true},
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			res := longestIncreasingSubsequence(u.in)
+			require.Equal(t, u.expected, res)
+		})
+	}
+}
+
+// testDOMNode is a JavaScript node that records the DOM commands used to
+// insert, remove and transition children, so tests can check how an update
+// changes the DOM.
+type testDOMNode struct {
+	Value
+	id       int
+	commands *domCommands
+}
+
+// recordDOM replaces the JavaScript nodes of the given HTML element and its
+// HTML descendants with nodes that record their DOM commands into the given
+// buffer. Nodes are numbered in depth-first order, starting from 1.
+func recordDOM(commands *domCommands, v UI) {
+	h, ok := v.(HTML)
+	if !ok {
+		return
+	}
+
+	h.setJSElement(testDOMNode{
+		Value:    h.JSValue(),
+		id:       commands.NewNode(),
+		commands: commands,
+	})
+	for _, child := range h.body() {
+		recordDOM(commands, child)
+	}
+}
+
+func (n testDOMNode) ref(v Wrapper) int {
+	if node, ok := v.JSValue().(testDOMNode); ok {
+		return node.id
+	}
+	return n.commands.Value(v)
+}
+
+func (n testDOMNode) appendChild(c Wrapper) {
+	n.commands.Record(domAppendChild, n.id, n.ref(c))
+}
+
+func (n testDOMNode) insertBefore(new, ref Wrapper) {
+	if ref == nil {
+		n.commands.Record(domAppendChild, n.id, n.ref(new))
+		return
+	}
+	n.commands.Record(domInsertBefore, n.id, n.ref(new), n.ref(ref))
+}
+
+func (n testDOMNode) replaceChild(new, old Wrapper) {
+	n.commands.Record(domReplaceChild, n.id, n.ref(new), n.ref(old))
+}
+
+func (n testDOMNode) removeChild(c Wrapper) {
+	n.commands.Record(domRemoveChild, n.id, n.ref(c))
+}
+
+func (n testDOMNode) transitionEnter(name string) {
+	n.commands.Record(domTransitionEnter, n.id, name)
+}
+
+func (n testDOMNode) transitionLeave(c Wrapper, name string) {
+	n.commands.Record(domTransitionLeave, n.id, n.ref(c), name)
+}
+
+func (n testDOMNode) transitionMove(name string) {
+	n.commands.Record(domTransitionMove, n.id, name)
+}
+
 func TestNodeManagerContext(t *testing.T) {
 	var m nodeManager
 
@@ -1037,6 +1515,26 @@ func TestNodeManagerEncode(t *testing.T) {
 		t.Log(b.String())
 	})
 
+	t.Run("encode svg", func(t *testing.T) {
+		var m nodeManager
+		var b bytes.Buffer
+
+		m.Encode(makeTestContext(), &b, Svg().Body(
+			Path().D("M0 0L10 10"),
+		))
+		require.Equal(t, "<svg xmlns=\"http://www.w3.org/2000/svg\">\n  <path d=\"M0 0L10 10\"></path>\n</svg>", b.String())
+		t.Log(b.String())
+	})
+
+	t.Run("encode mathml with a true boolean attribute", func(t *testing.T) {
+		var m nodeManager
+		var b bytes.Buffer
+
+		m.Encode(makeTestContext(), &b, Mo().Stretchy(true).Text("("))
+		require.Equal(t, `<mo stretchy="true">(</mo>`, b.String())
+		t.Log(b.String())
+	})
+
 	t.Run("encode html with single text child", func(t *testing.T) {
 		var m nodeManager
 		var b bytes.Buffer
@@ -1,8 +1,12 @@
 package app
 
+import (
+	"net/http"
+)
+
 var (
 	// NotFound is the ui element that is displayed when a request is not
-	// routed.
+	// routed. When pre-rendered, it sets the page status code to 404.
 	NotFound UI = &notFound{}
 )
 
@@ -11,6 +15,10 @@ type notFound struct {
 	Icon string
 }
 
+func (n *notFound) OnPreRender(ctx Context) {
+	ctx.Page().SetStatusCode(http.StatusNotFound)
+}
+
 func (n *notFound) OnMount(Context) {
 	links := Window().Get("document").Call("getElementsByTagName", "link")
 
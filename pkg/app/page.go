@@ -1,6 +1,7 @@
 package app
 
 import (
+	"net/http"
 	"net/url"
 	"strings"
 )
@@ -65,6 +66,20 @@ type Page interface {
 
 	// Set the Twitter card.
 	SetTwitterCard(v TwitterCard)
+
+	// Returns the HTTP status code of the response that serves the page.
+	StatusCode() int
+
+	// Sets the HTTP status code of the response that serves the page.
+	//
+	// Only works when pre-rendering.
+	SetStatusCode(v int)
+
+	// Returns the header of the HTTP response that serves the page. Modifying
+	// it sets headers such as Cache-Control or Vary.
+	//
+	// Only works when pre-rendering.
+	Header() http.Header
 }
 
 type requestPage struct {
@@ -82,6 +97,8 @@ type requestPage struct {
 	width          int
 	height         int
 	twitterCardMap map[string]string
+	statusCode     int
+	header         http.Header
 }
 
 func makeRequestPage(origin *url.URL, resolveURL func(string) string) requestPage {
@@ -173,6 +190,24 @@ func (p *requestPage) SetTwitterCard(v TwitterCard) {
 	p.twitterCardMap = v.toMap()
 }
 
+func (p *requestPage) StatusCode() int {
+	if p.statusCode == 0 {
+		return http.StatusOK
+	}
+	return p.statusCode
+}
+
+func (p *requestPage) SetStatusCode(v int) {
+	p.statusCode = v
+}
+
+func (p *requestPage) Header() http.Header {
+	if p.header == nil {
+		p.header = make(http.Header)
+	}
+	return p.header
+}
+
 type browserPage struct {
 	resolveURL func(string) string
 }
@@ -281,6 +316,17 @@ func (p browserPage) SetTwitterCard(v TwitterCard) {
 	}
 }
 
+func (p browserPage) StatusCode() int {
+	return http.StatusOK
+}
+
+func (p browserPage) SetStatusCode(v int) {
+}
+
+func (p browserPage) Header() http.Header {
+	return make(http.Header)
+}
+
 func (p browserPage) metaByName(v string) Value {
 	meta := Window().
 		Get("document").
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"net/http"
 	"net/url"
 	"testing"
 
@@ -57,4 +58,11 @@ func testPage(t *testing.T, p Page) {
 	require.NotZero(t, h)
 
 	p.SetTwitterCard(TwitterCard{Card: "summary"})
+
+	require.Equal(t, http.StatusOK, p.StatusCode())
+	p.SetStatusCode(http.StatusNotFound)
+	require.Equal(t, http.StatusNotFound, p.StatusCode())
+
+	p.Header().Set("Cache-Control", "max-age=60")
+	require.Equal(t, "max-age=60", p.Header().Get("Cache-Control"))
 }
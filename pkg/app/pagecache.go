@@ -0,0 +1,247 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/cache"
+	"github.com/maxence-charriere/go-app/v9/pkg/i18n"
+)
+
+// PageCache describes how the pages pre-rendered by a Handler are cached.
+// Cached pages are served without creating and rendering their components
+// again, which is suited for routes whose content rarely changes, such as
+// marketing or documentation pages.
+//
+// Route guards are still evaluated before a cached page is served. Only pages
+// responded with a 200 status code and that do not set cookies are cached.
+// Pages of cached routes are not streamed.
+type PageCache struct {
+	// The storage where pre-rendered pages are kept. When the storage expires
+	// its items, like cache.LRU or cache.Expire do, its item TTL must be
+	// greater than the route TTLs.
+	//
+	// Default: a cache.LRU whose item TTL is the greatest route TTL.
+	Storage cache.Cache
+
+	// The duration while a pre-rendered page is cached, indexed by route.
+	// Routes are identified by the path, pattern or regular expression they
+	// are registered with. Pages whose route is not listed are not cached.
+	//
+	// Example:
+	//
+	//	TTLs: map[string]time.Duration{
+	//	    "/":                  time.Minute * 10,
+	//	    "/docs/{path...}":    time.Hour,
+	//	    "^/blog/[a-z0-9-]+$": time.Hour,
+	//	}
+	TTLs map[string]time.Duration
+
+	// The query parameters that make a cached page vary. When nil, the whole
+	// query is taken into account. Set to an empty slice to ignore the query.
+	VaryQuery []string
+
+	// Reports whether a cached page varies with the language preferred by the
+	// client, as reported by the Accept-Language header or persisted with
+	// Context.SetLang. Cached pages always vary with the negotiated language
+	// when a catalog is set with SetCatalog.
+	VaryLanguage bool
+
+	once    sync.Once
+	mutex   sync.Mutex
+	keys    map[string]map[string]time.Time
+	pruneAt time.Time
+}
+
+// Invalidate removes the cached pages with the given paths, whatever the query
+// and language they were rendered with.
+func (c *PageCache) Invalidate(ctx context.Context, paths ...string) {
+	c.once.Do(c.init)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, path := range paths {
+		for key := range c.keys[path] {
+			c.Storage.Del(ctx, key)
+		}
+		delete(c.keys, path)
+	}
+}
+
+// InvalidateAll removes all the cached pages.
+func (c *PageCache) InvalidateAll(ctx context.Context) {
+	c.once.Do(c.init)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for path, keys := range c.keys {
+		for key := range keys {
+			c.Storage.Del(ctx, key)
+		}
+		delete(c.keys, path)
+	}
+}
+
+func (c *PageCache) init() {
+	if c.Storage == nil {
+		var ttl time.Duration
+		for _, routeTTL := range c.TTLs {
+			if routeTTL > ttl {
+				ttl = routeTTL
+			}
+		}
+		c.Storage = &cache.LRU{ItemTTL: ttl}
+	}
+
+	c.keys = make(map[string]map[string]time.Time)
+}
+
+// ttl returns the duration while the pages of the given route are cached. It
+// returns 0 when they are not cached.
+func (c *PageCache) ttl(route string) time.Duration {
+	if c == nil {
+		return 0
+	}
+	return c.TTLs[route]
+}
+
+// key returns the key that identifies the page requested by the given
+// request. The given language is the one negotiated with the catalog set with
+// SetCatalog, and is empty when no catalog is set.
+func (c *PageCache) key(r *http.Request, lang string) string {
+	var key strings.Builder
+	key.WriteString(r.URL.Path)
+
+	query := r.URL.Query()
+	if c.VaryQuery != nil {
+		varying := make(url.Values, len(c.VaryQuery))
+		for _, k := range c.VaryQuery {
+			if v, ok := query[k]; ok {
+				varying[k] = v
+			}
+		}
+		query = varying
+	}
+	if len(query) != 0 {
+		key.WriteByte('?')
+		key.WriteString(query.Encode())
+	}
+
+	switch {
+	case lang != "":
+		key.WriteByte('#')
+		key.WriteString(lang)
+
+	case c.VaryLanguage:
+		key.WriteByte('#')
+		key.WriteString(preferredLanguage(r))
+	}
+	return key.String()
+}
+
+func (c *PageCache) get(r *http.Request, lang string) (*cachedPage, bool) {
+	c.once.Do(c.init)
+
+	key := c.key(r, lang)
+	item, ok := c.Storage.Get(r.Context(), key)
+	if !ok {
+		c.forget(r.URL.Path, key)
+		return nil, false
+	}
+
+	page, ok := item.(*cachedPage)
+	if !ok || page.expiresAt.Before(time.Now()) {
+		c.Storage.Del(r.Context(), key)
+		c.forget(r.URL.Path, key)
+		return nil, false
+	}
+	return page, true
+}
+
+func (c *PageCache) set(r *http.Request, lang string, ttl time.Duration, page *cachedPage) {
+	if page.statusCode != http.StatusOK || page.header.Get("Set-Cookie") != "" {
+		return
+	}
+
+	c.once.Do(c.init)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	key := c.key(r, lang)
+	page.expiresAt = now.Add(ttl)
+	c.Storage.Set(r.Context(), key, page)
+
+	if !now.Before(c.pruneAt) {
+		c.prune(r.Context(), now)
+		c.pruneAt = page.expiresAt
+	}
+
+	keys, ok := c.keys[r.URL.Path]
+	if !ok {
+		keys = make(map[string]time.Time)
+		c.keys[r.URL.Path] = keys
+	}
+	keys[key] = page.expiresAt
+}
+
+// forget removes the given key from the keys of the pages cached for the given
+// path. It is called when the page is no longer in the storage, whether it
+// expired or was evicted.
+func (c *PageCache) forget(path, key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	keys := c.keys[path]
+	delete(keys, key)
+	if len(keys) == 0 {
+		delete(c.keys, path)
+	}
+}
+
+// prune removes the keys of the cached pages that are expired, so the keys of
+// pages that are never requested again do not pile up. It must be called with
+// the mutex locked.
+func (c *PageCache) prune(ctx context.Context, now time.Time) {
+	for path, keys := range c.keys {
+		for key, expiresAt := range keys {
+			if expiresAt.Before(now) {
+				c.Storage.Del(ctx, key)
+				delete(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			delete(c.keys, path)
+		}
+	}
+}
+
+// cachedPage is a pre-rendered page stored in a PageCache.
+type cachedPage struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+	expiresAt  time.Time
+}
+
+func (p *cachedPage) Size() int {
+	return len(p.body)
+}
+
+// preferredLanguage returns the language persisted by the client with
+// Context.SetLang, or else the language with the highest priority in the
+// Accept-Language header of the given request.
+func preferredLanguage(r *http.Request) string {
+	if c, err := r.Cookie(langCookie); err == nil && c.Value != "" {
+		return strings.ToLower(c.Value)
+	}
+
+	if languages := i18n.ParseAcceptLanguage(r.Header.Get("Accept-Language")); len(languages) != 0 {
+		return strings.ToLower(languages[0])
+	}
+	return ""
+}
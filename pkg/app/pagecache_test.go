@@ -0,0 +1,175 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageCacheKey(t *testing.T) {
+	utests := []struct {
+		scenario       string
+		cache          *PageCache
+		target         string
+		acceptLanguage string
+		langCookie     string
+		lang           string
+		expected       string
+	}{
+		{
+			scenario: "path",
+			target:   "/hello",
+			expected: "/hello",
+		},
+		{
+			scenario: "whole query",
+			target:   "/hello?b=2&a=1",
+			expected: "/hello?a=1&b=2",
+		},
+		{
+			scenario: "varying query",
+			cache:    &PageCache{VaryQuery: []string{"a"}},
+			target:   "/hello?b=2&a=1",
+			expected: "/hello?a=1",
+		},
+		{
+			scenario: "ignored query",
+			cache:    &PageCache{VaryQuery: []string{}},
+			target:   "/hello?b=2&a=1",
+			expected: "/hello",
+		},
+		{
+			scenario:       "language",
+			cache:          &PageCache{VaryLanguage: true},
+			target:         "/hello",
+			acceptLanguage: "en;q=0.8, fr-FR",
+			expected:       "/hello#fr-fr",
+		},
+		{
+			scenario:       "persisted language",
+			cache:          &PageCache{VaryLanguage: true},
+			target:         "/hello",
+			acceptLanguage: "en;q=0.8, fr-FR",
+			langCookie:     "de",
+			expected:       "/hello#de",
+		},
+		{
+			scenario:       "negotiated language",
+			cache:          &PageCache{},
+			target:         "/hello",
+			acceptLanguage: "fr-CA",
+			lang:           "fr",
+			expected:       "/hello#fr",
+		},
+		{
+			scenario: "language without header",
+			cache:    &PageCache{VaryLanguage: true},
+			target:   "/hello",
+			expected: "/hello#",
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, u.target, nil)
+			if u.acceptLanguage != "" {
+				r.Header.Set("Accept-Language", u.acceptLanguage)
+			}
+			if u.langCookie != "" {
+				r.AddCookie(&http.Cookie{Name: langCookie, Value: u.langCookie})
+			}
+			c := u.cache
+			if c == nil {
+				c = &PageCache{}
+			}
+			require.Equal(t, u.expected, c.key(r, u.lang))
+		})
+	}
+}
+
+func TestPageCache(t *testing.T) {
+	t.Run("default storage expires after the greatest ttl", func(t *testing.T) {
+		c := PageCache{TTLs: map[string]time.Duration{
+			"/a": time.Minute,
+			"/b": time.Hour,
+		}}
+		c.once.Do(c.init)
+		require.Equal(t, time.Hour, c.Storage.(*cache.LRU).ItemTTL)
+	})
+
+	t.Run("nil cache does not cache", func(t *testing.T) {
+		var c *PageCache
+		require.Zero(t, c.ttl("/"))
+	})
+
+	t.Run("page is stored and invalidated", func(t *testing.T) {
+		c := PageCache{Storage: &cache.Expire{ItemTTL: time.Minute}}
+		r := httptest.NewRequest(http.MethodGet, "/hello?a=1", nil)
+
+		c.set(r, "", time.Minute, &cachedPage{
+			statusCode: http.StatusOK,
+			body:       []byte("hello"),
+		})
+		page, ok := c.get(r, "")
+		require.True(t, ok)
+		require.Equal(t, "hello", string(page.body))
+
+		c.Invalidate(context.Background(), "/hello")
+		_, ok = c.get(r, "")
+		require.False(t, ok)
+	})
+
+	t.Run("keys of expired pages are pruned", func(t *testing.T) {
+		c := PageCache{Storage: &cache.Expire{ItemTTL: time.Minute}}
+		page := func() *cachedPage {
+			return &cachedPage{statusCode: http.StatusOK}
+		}
+
+		expired := httptest.NewRequest(http.MethodGet, "/expired", nil)
+		c.set(expired, "", -time.Second, page())
+		require.Len(t, c.keys["/expired"], 1)
+
+		c.set(httptest.NewRequest(http.MethodGet, "/hello", nil), "", time.Minute, page())
+		require.NotContains(t, c.keys, "/expired")
+		require.Len(t, c.keys["/hello"], 1)
+	})
+
+	t.Run("keys of evicted pages are pruned", func(t *testing.T) {
+		c := PageCache{Storage: &cache.Expire{ItemTTL: time.Minute}}
+		r := httptest.NewRequest(http.MethodGet, "/hello", nil)
+
+		c.set(r, "", time.Minute, &cachedPage{statusCode: http.StatusOK})
+		c.Storage.Del(context.Background(), c.key(r, ""))
+		require.Len(t, c.keys["/hello"], 1)
+
+		_, ok := c.get(r, "")
+		require.False(t, ok)
+		require.NotContains(t, c.keys, "/hello")
+	})
+
+	t.Run("page with error status is not stored", func(t *testing.T) {
+		c := PageCache{}
+		r := httptest.NewRequest(http.MethodGet, "/hello", nil)
+
+		c.set(r, "", time.Minute, &cachedPage{statusCode: http.StatusInternalServerError})
+		_, ok := c.get(r, "")
+		require.False(t, ok)
+	})
+
+	t.Run("page setting cookies is not stored", func(t *testing.T) {
+		c := PageCache{}
+		r := httptest.NewRequest(http.MethodGet, "/hello", nil)
+
+		c.set(r, "", time.Minute, &cachedPage{
+			statusCode: http.StatusOK,
+			header:     http.Header{"Set-Cookie": {"session=42"}},
+		})
+		_, ok := c.get(r, "")
+		require.False(t, ok)
+	})
+}
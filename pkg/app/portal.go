@@ -0,0 +1,119 @@
+package app
+
+import (
+	"reflect"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+// Portal creates a UI element that displays the given elements within the DOM
+// element matching the given CSS selector instead of its parent, which allows
+// content such as modals, toasts or tooltips to escape containers that clip
+// their overflow. When the selector is empty, the elements are displayed at the
+// end of the body.
+//
+// The elements are mounted, updated and dismounted along with the portal, like
+// regular children, and remain part of the component tree: their events and
+// dispatched functions reach the components that enclose the portal.
+//
+// The elements are wrapped in a div element with a "data-goapp-portal"
+// attribute, which is preserved by KeepBodyClean. Portals are not
+// pre-rendered: their content is displayed once the app is loaded in the
+// browser.
+//
+// Example:
+//
+//	app.Portal("#modals",
+//	    app.Div().Class("modal").Text("hello"),
+//	)
+func Portal(target string, elems ...UI) UI {
+	return &portal{
+		target: target,
+		container: Div().
+			DataSet("goapp-portal", target).
+			Body(elems...),
+	}
+}
+
+type portal struct {
+	target        string
+	container     HTML
+	targetElement Value
+	placeholder   Value
+	parentElement UI
+}
+
+func (p *portal) JSValue() Value {
+	return p.placeholder
+}
+
+func (p *portal) Mounted() bool {
+	return p.placeholder != nil
+}
+
+func (p *portal) parent() UI {
+	return p.parentElement
+}
+
+func (p *portal) setParent(v UI) UI {
+	p.parentElement = v
+	return p
+}
+
+func (m nodeManager) mountPortal(ctx Context, depth uint, v *portal) (UI, error) {
+	if v.Mounted() {
+		return nil, errors.New("portal is already mounted").
+			WithTag("parent-type", reflect.TypeOf(v.parent())).
+			WithTag("target", v.target)
+	}
+
+	container, err := m.Mount(ctx, depth, adopt(v, v.container))
+	if err != nil {
+		return nil, errors.New("mounting portal content failed").
+			WithTag("target", v.target).
+			WithTag("depth", depth).
+			Wrap(err)
+	}
+	v.container = container.(HTML)
+	v.container.setParent(v)
+
+	v.targetElement = portalTarget(v.target)
+	v.targetElement.appendChild(v.container)
+	v.placeholder = Window().createTextNode("")
+	return v, nil
+}
+
+func (m nodeManager) updatePortal(ctx Context, v, new *portal) (UI, error) {
+	container, err := m.Update(ctx, v.container, new.container)
+	if err != nil {
+		return nil, errors.New("updating portal content failed").
+			WithTag("target", v.target).
+			Wrap(err)
+	}
+	v.container = container.(HTML)
+	return v, nil
+}
+
+func (m nodeManager) dismountPortal(v *portal) {
+	if v.targetElement != nil && v.container.Mounted() {
+		v.targetElement.removeChild(v.container)
+	}
+	m.Dismount(v.container)
+	v.targetElement = nil
+	v.placeholder = nil
+}
+
+// portalTarget returns the DOM element that matches the given selector. It
+// returns the body when the selector is empty or does not match any element.
+func portalTarget(selector string) Value {
+	document := Window().Get("document")
+	if selector != "" && IsClient {
+		if target := document.Call("querySelector", selector); target.Truthy() {
+			return target
+		}
+
+		Log(errors.New("portal target not found").
+			WithTag("selector", selector))
+	}
+	return document.Get("body")
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortal(t *testing.T) {
+	t.Run("portal is mounted", func(t *testing.T) {
+		var m nodeManager
+
+		p := Portal("#modals", H1().Text("hello"))
+		div, err := m.Mount(makeTestContext(), 1, Div().Body(p))
+		require.NoError(t, err)
+		require.True(t, p.Mounted())
+		require.NotNil(t, p.JSValue())
+		require.Same(t, div, p.parent())
+
+		container := p.(*portal).container
+		require.True(t, container.Mounted())
+		require.Same(t, p, container.parent())
+		require.Equal(t, uint(2), container.depth())
+
+		err = TestMatch(div, TestUIDescriptor{
+			Path:     TestPath(0, 0),
+			Expected: H1(),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("mounting a mounted portal returns an error", func(t *testing.T) {
+		var m nodeManager
+
+		p, err := m.Mount(makeTestContext(), 1, Portal(""))
+		require.NoError(t, err)
+
+		_, err = m.Mount(makeTestContext(), 1, p)
+		require.Error(t, err)
+	})
+
+	t.Run("portal is updated", func(t *testing.T) {
+		var m nodeManager
+
+		p, err := m.Mount(makeTestContext(), 1, Portal("#modals", H1().Text("hello")))
+		require.NoError(t, err)
+
+		updated, err := m.Update(makeTestContext(), p, Portal("#modals", H1().Text("bye")))
+		require.NoError(t, err)
+		require.Same(t, p, updated)
+
+		err = TestMatch(p, TestUIDescriptor{
+			Path:     TestPath(0, 0),
+			Expected: Text("bye"),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("portal with another target is replaced", func(t *testing.T) {
+		var m nodeManager
+
+		p := Portal("#modals", H1())
+		div, err := m.Mount(makeTestContext(), 1, Div().Body(p))
+		require.NoError(t, err)
+		require.False(t, m.CanUpdate(p, Portal("#toasts", H1())))
+
+		_, err = m.Update(makeTestContext(), div, Div().Body(Portal("#toasts", H1())))
+		require.NoError(t, err)
+		require.False(t, p.Mounted())
+		require.Equal(t, "#toasts", div.(HTML).body()[0].(*portal).target)
+	})
+
+	t.Run("portal is dismounted", func(t *testing.T) {
+		var m nodeManager
+
+		p := Portal("", H1())
+		_, err := m.Mount(makeTestContext(), 1, p)
+		require.NoError(t, err)
+
+		container := p.(*portal).container
+		m.Dismount(p)
+		require.False(t, p.Mounted())
+		require.False(t, container.Mounted())
+	})
+
+	t.Run("component within a portal receives events", func(t *testing.T) {
+		updates := make(map[UI]struct{})
+		ctx := makeTestContext()
+		ctx.addComponentUpdate = func(c Composer) {
+			updates[c] = struct{}{}
+		}
+
+		var m nodeManager
+		compo := &hello{}
+		div, err := m.Mount(ctx, 1, Div().Body(Portal("", compo)))
+		require.NoError(t, err)
+
+		m.NotifyComponentEvent(ctx, div, nav{})
+		require.NotEmpty(t, compo.onNavURL)
+		require.Contains(t, updates, compo)
+	})
+
+	t.Run("portal is not encoded", func(t *testing.T) {
+		var m nodeManager
+		var b bytes.Buffer
+
+		m.Encode(makeTestContext(), &b, Div().Body(Portal("", H1().Text("hello"))))
+		require.NotContains(t, b.String(), "hello")
+	})
+}
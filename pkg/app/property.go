@@ -0,0 +1,79 @@
+package app
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+// properties represents the JavaScript properties of an HTML element, which
+// unlike attributes are not limited to strings.
+type properties map[string]any
+
+func (p properties) Set(name string, value any) {
+	p[name] = value
+}
+
+// setJSProperty sets the given property on the given JavaScript element.
+// Values that can not be directly converted to JavaScript, such as structs,
+// are converted by going through their JSON representation.
+func setJSProperty(jsElement Value, name string, value any) {
+	jsElement.Set(name, jsPropertyValue(value))
+}
+
+// deleteJSProperty resets the given property of the given JavaScript element to
+// undefined.
+func deleteJSProperty(jsElement Value, name string) {
+	jsElement.Set(name, Undefined())
+}
+
+// jsPropertyValue returns the given value in a form that can be converted to
+// JavaScript. Maps and slices are converted recursively.
+func jsPropertyValue(v any) any {
+	switch v := v.(type) {
+	case nil,
+		bool,
+		string,
+		int,
+		int8,
+		int16,
+		int32,
+		int64,
+		uint,
+		uint8,
+		uint16,
+		uint32,
+		uint64,
+		uintptr,
+		float32,
+		float64:
+		return v
+
+	case Wrapper:
+		return cleanArg(v)
+
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, val := range v {
+			m[k] = jsPropertyValue(val)
+		}
+		return m
+
+	case []any:
+		s := make([]any, len(v))
+		for i, val := range v {
+			s[i] = jsPropertyValue(val)
+		}
+		return s
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		Log(errors.New("converting property value to javascript failed").
+			WithTag("type", reflect.TypeOf(v)).
+			Wrap(err))
+		return nil
+	}
+	return cleanArg(Window().Get("JSON").Call("parse", string(b)))
+}
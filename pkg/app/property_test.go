@@ -0,0 +1,63 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSPropertyValue(t *testing.T) {
+	t.Run("javascript compatible value is passed through", func(t *testing.T) {
+		values := []any{
+			nil,
+			true,
+			"hello",
+			42,
+			3.14,
+			map[string]any{"foo": "bar"},
+			[]any{"foo", 42},
+		}
+
+		for _, v := range values {
+			require.Equal(t, v, jsPropertyValue(v))
+		}
+	})
+
+	t.Run("nested values are converted", func(t *testing.T) {
+		v := jsPropertyValue(map[string]any{
+			"name": "foo",
+			"tags": []string{"a", "b"},
+			"at":   time.Now(),
+			"items": []any{
+				42,
+				struct{ Name string }{Name: "bar"},
+				map[string]any{"ids": []int{1, 2}},
+			},
+		})
+
+		m, ok := v.(map[string]any)
+		require.True(t, ok)
+		require.Equal(t, "foo", m["name"])
+		require.Implements(t, (*Value)(nil), m["tags"])
+		require.Implements(t, (*Value)(nil), m["at"])
+
+		items, ok := m["items"].([]any)
+		require.True(t, ok)
+		require.Equal(t, 42, items[0])
+		require.Implements(t, (*Value)(nil), items[1])
+
+		nested, ok := items[2].(map[string]any)
+		require.True(t, ok)
+		require.Implements(t, (*Value)(nil), nested["ids"])
+	})
+
+	t.Run("struct value is converted", func(t *testing.T) {
+		v := jsPropertyValue(struct{ Name string }{Name: "foo"})
+		require.Implements(t, (*Value)(nil), v)
+	})
+
+	t.Run("value that can not be converted returns nil", func(t *testing.T) {
+		require.Nil(t, jsPropertyValue(make(chan int)))
+	})
+}
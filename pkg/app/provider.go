@@ -0,0 +1,91 @@
+package app
+
+import (
+	"reflect"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+// Provider is the interface that describes a component that provides values
+// to its descendants.
+type Provider interface {
+	// OnProvide is called with the component context before the component
+	// is rendered for the first time. Values provided with Provide during
+	// this call are available to the descendants from their first render.
+	OnProvide(Context)
+}
+
+// Provide makes the given value available to the component bound to the given
+// context and to its descendants, which retrieve it with Lookup or LookupFrom.
+// Values are identified by their type, and a value provided by a component
+// overrides the one of the same type provided by its ancestors.
+//
+// Values are usually provided in OnProvide, which is called before the
+// descendants are rendered. Values provided in OnMount or OnPreRender are
+// only visible to the descendants once they are rendered, from their own
+// OnMount or OnPreRender for example. Since provided values do not update the
+// components that look them up, a value that changes over time should be
+// wrapped in a Signal.
+//
+// Unlike states, provided values are scoped to a component subtree and are
+// neither serialized nor shared across pages.
+//
+// Example:
+//
+//	func (c *root) OnProvide(ctx app.Context) {
+//	    app.Provide[Logger](ctx, newLogger())
+//	}
+func Provide[T any](ctx Context, v T) {
+	c, ok := component(ctx.sourceElement)
+	if !ok {
+		Log(errors.New("providing value failed").
+			WithTag("reason", "context is not bound to a component").
+			WithTag("type", providedType[T]()))
+		return
+	}
+	c.provide(providedType[T](), v)
+}
+
+// Lookup returns the value of the given type provided by the closest component
+// among the element bound to the given context and its ancestors. It returns
+// false when no such value was provided.
+//
+// Example:
+//
+//	func (c *child) OnMount(ctx app.Context) {
+//	    if logger, ok := app.Lookup[Logger](ctx); ok {
+//	        c.logger = logger
+//	    }
+//	}
+func Lookup[T any](ctx Context) (T, bool) {
+	return LookupFrom[T](ctx.sourceElement)
+}
+
+// LookupFrom returns the value of the given type provided by the closest
+// component among the given element and its ancestors. It returns false when
+// no such value was provided. Unlike Lookup, it does not require a context,
+// which allows values to be looked up when a component renders.
+//
+// Example:
+//
+//	func (c *child) Render() app.UI {
+//	    theme, _ := app.LookupFrom[Theme](c)
+//	    return app.Div().Class(theme.Class)
+//	}
+func LookupFrom[T any](v UI) (T, bool) {
+	k := providedType[T]()
+	for c, ok := component(v); ok; c, ok = component(c.parent()) {
+		if v, ok := c.provided(k); ok {
+			// A nil interface value does not convert to its type.
+			t, _ := v.(T)
+			return t, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+func providedType[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type providerCompo struct {
+	Compo
+
+	child UI
+}
+
+func (c *providerCompo) Render() UI {
+	return Div().Body(c.child)
+}
+
+type preRenderProviderCompo struct {
+	providerCompo
+}
+
+func (c *preRenderProviderCompo) OnPreRender(ctx Context) {
+	Provide(ctx, "pre-rendered")
+}
+
+type mountProviderCompo struct {
+	providerCompo
+}
+
+func (c *mountProviderCompo) OnProvide(ctx Context) {
+	Provide(ctx, "provided")
+}
+
+type renderConsumerCompo struct {
+	Compo
+}
+
+func (c *renderConsumerCompo) Render() UI {
+	s, _ := LookupFrom[string](c)
+	return Text(s)
+}
+
+type consumerCompo struct {
+	Compo
+
+	value string
+}
+
+func (c *consumerCompo) OnPreRender(ctx Context) {
+	c.value, _ = Lookup[string](ctx)
+}
+
+func (c *consumerCompo) Render() UI {
+	return Text(c.value)
+}
+
+type providedLogger interface {
+	Log(string)
+}
+
+type providedTestLogger struct{}
+
+func (providedTestLogger) Log(string) {}
+
+func TestProvide(t *testing.T) {
+	var m nodeManager
+	ctx := makeTestContext()
+
+	t.Run("descendant looks up provided value", func(t *testing.T) {
+		child := &hello{}
+		provider := &providerCompo{child: Span().Body(child)}
+		_, err := m.Mount(ctx, 1, provider)
+		require.NoError(t, err)
+
+		Provide(m.context(ctx, provider), "hello")
+		Provide[providedLogger](m.context(ctx, provider), providedTestLogger{})
+
+		s, ok := Lookup[string](m.context(ctx, child))
+		require.True(t, ok)
+		require.Equal(t, "hello", s)
+
+		logger, ok := Lookup[providedLogger](m.context(ctx, child))
+		require.True(t, ok)
+		require.Equal(t, providedTestLogger{}, logger)
+
+		s, ok = Lookup[string](m.context(ctx, child.root()))
+		require.True(t, ok)
+		require.Equal(t, "hello", s)
+	})
+
+	t.Run("closest provided value is looked up", func(t *testing.T) {
+		child := &hello{}
+		inner := &providerCompo{child: child}
+		outer := &providerCompo{child: inner}
+		_, err := m.Mount(ctx, 1, outer)
+		require.NoError(t, err)
+
+		Provide(m.context(ctx, outer), 21)
+		Provide(m.context(ctx, outer), "outer")
+		Provide(m.context(ctx, inner), 42)
+
+		i, ok := Lookup[int](m.context(ctx, child))
+		require.True(t, ok)
+		require.Equal(t, 42, i)
+
+		s, ok := Lookup[string](m.context(ctx, child))
+		require.True(t, ok)
+		require.Equal(t, "outer", s)
+
+		i, ok = Lookup[int](m.context(ctx, outer))
+		require.True(t, ok)
+		require.Equal(t, 21, i)
+	})
+
+	t.Run("value provided from an html element context is scoped to its component", func(t *testing.T) {
+		child := &hello{}
+		provider := &providerCompo{child: child}
+		_, err := m.Mount(ctx, 1, provider)
+		require.NoError(t, err)
+
+		Provide(m.context(ctx, provider.root()), 3.14)
+
+		f, ok := Lookup[float64](m.context(ctx, child))
+		require.True(t, ok)
+		require.Equal(t, 3.14, f)
+	})
+
+	t.Run("value provided through a portal is looked up", func(t *testing.T) {
+		child := &hello{}
+		provider := &providerCompo{child: Portal("", child)}
+		_, err := m.Mount(ctx, 1, provider)
+		require.NoError(t, err)
+
+		Provide(m.context(ctx, provider), true)
+
+		b, ok := Lookup[bool](m.context(ctx, child))
+		require.True(t, ok)
+		require.True(t, b)
+	})
+
+	t.Run("nil interface value is looked up", func(t *testing.T) {
+		child := &hello{}
+		provider := &providerCompo{child: child}
+		_, err := m.Mount(ctx, 1, provider)
+		require.NoError(t, err)
+
+		Provide[providedLogger](m.context(ctx, provider), nil)
+
+		logger, ok := Lookup[providedLogger](m.context(ctx, child))
+		require.True(t, ok)
+		require.Nil(t, logger)
+	})
+
+	t.Run("value not provided is not looked up", func(t *testing.T) {
+		child := &hello{}
+		_, err := m.Mount(ctx, 1, &providerCompo{child: child})
+		require.NoError(t, err)
+
+		s, ok := Lookup[string](m.context(ctx, child))
+		require.False(t, ok)
+		require.Empty(t, s)
+	})
+
+	t.Run("value provided before descendants are pre-rendered is looked up", func(t *testing.T) {
+		e := newTestEngine()
+		consumer := &consumerCompo{}
+		require.NoError(t, e.Load(&preRenderProviderCompo{
+			providerCompo: providerCompo{child: consumer},
+		}))
+		e.ConsumeAll()
+		require.Equal(t, "pre-rendered", consumer.value)
+	})
+
+	t.Run("value provided before the first render is looked up from descendant render", func(t *testing.T) {
+		consumer := &renderConsumerCompo{}
+		_, err := m.Mount(ctx, 1, &mountProviderCompo{
+			providerCompo: providerCompo{child: Span().Body(consumer)},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "provided", consumer.root().(*text).value)
+	})
+
+	t.Run("providing from a context without component is logged", func(t *testing.T) {
+		var logs int
+		defer func(logger func(string, ...any)) {
+			DefaultLogger = logger
+		}(DefaultLogger)
+		DefaultLogger = func(string, ...any) {
+			logs++
+		}
+
+		div, err := m.Mount(ctx, 1, Div())
+		require.NoError(t, err)
+
+		Provide(m.context(ctx, div), "hello")
+		require.Equal(t, 1, logs)
+
+		_, ok := Lookup[string](m.context(ctx, div))
+		require.False(t, ok)
+	})
+}
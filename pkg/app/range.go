@@ -29,6 +29,10 @@ type RangeLoop interface {
 
 // Range returns a range loop that iterates within the given source. Source must
 // be a slice, an array or a map with strings as keys.
+//
+// Elements created by the loop should be given a key, either with the Key
+// method of HTML elements or by implementing Keyer for components, so they are
+// moved rather than recreated when the source is reordered.
 func Range(src any) RangeLoop {
 	return rangeLoop{source: src}
 }
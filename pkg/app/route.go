@@ -1,9 +1,13 @@
 package app
 
 import (
+	"net/url"
 	"reflect"
 	"regexp"
+	"strings"
 	"sync"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
 )
 
 var (
@@ -19,8 +23,11 @@ var (
 //	Route("/home", func() Composer {
 //	    return NewHomeComponent()
 //	})
-func Route(path string, newComponent func() Composer) {
-	routes.route(path, newComponent)
+//
+// The given guards are evaluated, in order, before navigating to the route.
+// See RouteGuard.
+func Route(path string, newComponent func() Composer, guards ...RouteGuard) {
+	routes.route(path, newComponent, guards...)
 }
 
 // RouteWithRegexp associates a URL path pattern with a function that generates
@@ -33,10 +40,73 @@ func Route(path string, newComponent func() Composer) {
 //	RouteWithRegexp("^/users/[0-9]+$", func() Composer {
 //	    return NewUserComponent()
 //	})
-func RouteWithRegexp(pattern string, newComponent func() Composer) {
-	routes.routeWithRegexp(pattern, newComponent)
+//
+// The given guards are evaluated, in order, before navigating to the route.
+// See RouteGuard.
+func RouteWithRegexp(pattern string, newComponent func() Composer, guards ...RouteGuard) {
+	routes.routeWithRegexp(pattern, newComponent, guards...)
+}
+
+// RouteWithPattern associates a URL path pattern with a function that
+// generates a new Composer component. Patterns are made of slash-separated
+// segments where a segment wrapped in braces, such as "{id}", captures the
+// matching path segment, and a last segment such as "{path...}" captures the
+// remainder of the path.
+//
+// Captured values are available from Context.RouteParams. Exact paths
+// registered with Route take priority over patterns, which are evaluated in
+// registration order before regular expressions.
+//
+// Example:
+//
+//	RouteWithPattern("/users/{id}/posts/{slug...}", func() Composer {
+//	    return NewUserPostComponent()
+//	})
+//
+// The given guards are evaluated, in order, before navigating to the route:
+//
+//	RouteWithPattern("/users/{id}/edit", newUserEditor, requireAuth)
+func RouteWithPattern(pattern string, newComponent func() Composer, guards ...RouteGuard) {
+	routes.routeWithPattern("", pattern, newComponent, guards...)
 }
 
+// NamedRoute behaves like RouteWithPattern and additionally registers the
+// pattern under the given name, allowing RouteURL to build paths that target
+// the route without hardcoding them.
+//
+// Example:
+//
+//	NamedRoute("user", "/users/{id}", func() Composer {
+//	    return NewUserComponent()
+//	})
+//
+// The given guards are evaluated, in order, before navigating to the route.
+// See RouteGuard.
+func NamedRoute(name, pattern string, newComponent func() Composer, guards ...RouteGuard) {
+	routes.routeWithPattern(name, pattern, newComponent, guards...)
+}
+
+// RouteURL returns the path of the route registered with the given name,
+// where the pattern parameters are replaced by the given values. It logs an
+// error and returns an empty string when the route does not exist or when a
+// parameter is missing.
+//
+// Example:
+//
+//	A().Href(RouteURL("user", RouteParams{"id": "42"})) // href="/users/42"
+func RouteURL(name string, params RouteParams) string {
+	u, err := routes.url(name, params)
+	if err != nil {
+		Log(errors.New("building route url failed").Wrap(err))
+		return ""
+	}
+	return u
+}
+
+// RouteParams represents the values captured by a route pattern, indexed by
+// parameter name.
+type RouteParams map[string]string
+
 // NewZeroComponentFactory returns a function that, when invoked, creates and
 // returns a new instance of the same type as the provided component. The new
 // instance is initialized with zero values for all its fields.
@@ -57,69 +127,289 @@ func NewZeroComponentFactory(c Composer) func() Composer {
 }
 
 type router struct {
-	mu               sync.RWMutex
-	routes           map[string]func() Composer
-	routesWithRegexp []regexpRoute
+	mu                sync.RWMutex
+	routes            map[string]routeTarget
+	routesWithPattern []patternRoute
+	routesWithRegexp  []regexpRoute
+	namedRoutes       map[string]routePattern
 }
 
 func makeRouter() router {
 	return router{
-		routes: make(map[string]func() Composer),
+		routes:      make(map[string]routeTarget),
+		namedRoutes: make(map[string]routePattern),
 	}
 }
 
-func (r *router) route(path string, newComponent func() Composer) {
+func (r *router) route(path string, newComponent func() Composer, guards ...RouteGuard) {
+	r.addRoute(path, routeTarget{
+		newComponent: newComponent,
+		guards:       guards,
+	})
+}
+
+func (r *router) addRoute(path string, target routeTarget) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.routes[path] = newComponent
+	r.routes[path] = target
 }
 
-func (r *router) routeWithRegexp(pattern string, newComponent func() Composer) {
+func (r *router) routeWithRegexp(pattern string, newComponent func() Composer, guards ...RouteGuard) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	r.routesWithRegexp = append(r.routesWithRegexp, regexpRoute{
-		regexp:       regexp.MustCompile(pattern),
+		regexp: regexp.MustCompile(pattern),
+		routeTarget: routeTarget{
+			newComponent: newComponent,
+			guards:       guards,
+		},
+	})
+}
+
+func (r *router) routeWithPattern(name, pattern string, newComponent func() Composer, guards ...RouteGuard) {
+	r.addRouteWithPattern(name, pattern, routeTarget{
 		newComponent: newComponent,
+		guards:       guards,
 	})
 }
 
+func (r *router) addRouteWithPattern(name, pattern string, target routeTarget) {
+	p := parseRoutePattern(pattern)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.routesWithPattern = append(r.routesWithPattern, patternRoute{
+		pattern:     p,
+		routeTarget: target,
+	})
+	if name != "" {
+		r.namedRoutes[name] = p
+	}
+}
+
 func (r *router) routed(path string) bool {
+	_, routed := r.match(path)
+	return routed
+}
+
+func (r *router) createComponent(path string) (Composer, bool) {
+	match, routed := r.match(path)
+	if !routed {
+		return nil, false
+	}
+	return match.newComponent(), true
+}
+
+func (r *router) match(path string) (routeMatch, bool) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if _, routed := r.routes[path]; routed {
-		return true
+	if target, routed := r.routes[path]; routed {
+		return routeMatch{
+			routeTarget: target,
+			route:       path,
+		}, true
+	}
+
+	for _, rwp := range r.routesWithPattern {
+		if params, ok := rwp.pattern.match(path); ok {
+			return routeMatch{
+				routeTarget: rwp.routeTarget,
+				route:       rwp.pattern.raw,
+				params:      params,
+			}, true
+		}
 	}
 
 	for _, rwr := range r.routesWithRegexp {
 		if rwr.regexp.MatchString(path) {
-			return true
+			return routeMatch{
+				routeTarget: rwr.routeTarget,
+				route:       rwr.regexp.String(),
+			}, true
 		}
 	}
 
-	return false
+	return routeMatch{}, false
 }
 
-func (r *router) createComponent(path string) (Composer, bool) {
+func (r *router) url(name string, params RouteParams) (string, error) {
 	r.mu.RLock()
-	defer r.mu.RUnlock()
+	pattern, ok := r.namedRoutes[name]
+	r.mu.RUnlock()
 
-	if newComponent, routed := r.routes[path]; routed {
-		return newComponent(), true
+	if !ok {
+		return "", errors.New("route not found").WithTag("name", name)
 	}
 
-	for _, rwr := range r.routesWithRegexp {
-		if rwr.regexp.MatchString(path) {
-			return rwr.newComponent(), true
-		}
+	u, err := pattern.url(params)
+	if err != nil {
+		return "", errors.New("building url failed").
+			WithTag("name", name).
+			WithTag("pattern", pattern.raw).
+			Wrap(err)
 	}
+	return u, nil
+}
 
-	return nil, false
+type routeTarget struct {
+	newComponent func() Composer
+	layouts      []*routeLayout
+	guards       []RouteGuard
+}
+
+type routeMatch struct {
+	routeTarget
+	route  string
+	params RouteParams
 }
 
 type regexpRoute struct {
-	regexp       *regexp.Regexp
-	newComponent func() Composer
+	routeTarget
+	regexp *regexp.Regexp
+}
+
+type patternRoute struct {
+	routeTarget
+	pattern routePattern
+}
+
+type routePattern struct {
+	raw      string
+	segments []routeSegment
+}
+
+type routeSegment struct {
+	value    string
+	param    bool
+	wildcard bool
+}
+
+func parseRoutePattern(pattern string) routePattern {
+	if !strings.HasPrefix(pattern, "/") {
+		panic(errors.New("route pattern does not start with a slash").
+			WithTag("pattern", pattern))
+	}
+
+	rawSegments := strings.Split(pattern[1:], "/")
+	segments := make([]routeSegment, len(rawSegments))
+	params := make(map[string]struct{}, len(rawSegments))
+
+	for i, s := range rawSegments {
+		if !strings.HasPrefix(s, "{") && !strings.HasSuffix(s, "}") {
+			segments[i] = routeSegment{value: s}
+			continue
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+		wildcard := strings.HasSuffix(name, "...")
+		name = strings.TrimSuffix(name, "...")
+
+		switch {
+		case !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}"):
+			panic(errors.New("route pattern has an unclosed parameter").
+				WithTag("pattern", pattern).
+				WithTag("segment", s))
+
+		case name == "" || strings.ContainsAny(name, "{}/"):
+			panic(errors.New("route pattern has an invalid parameter name").
+				WithTag("pattern", pattern).
+				WithTag("segment", s))
+
+		case wildcard && i != len(rawSegments)-1:
+			panic(errors.New("route pattern wildcard is not the last segment").
+				WithTag("pattern", pattern).
+				WithTag("segment", s))
+		}
+
+		if _, exists := params[name]; exists {
+			panic(errors.New("route pattern has a duplicated parameter").
+				WithTag("pattern", pattern).
+				WithTag("param", name))
+		}
+		params[name] = struct{}{}
+
+		segments[i] = routeSegment{
+			value:    name,
+			param:    true,
+			wildcard: wildcard,
+		}
+	}
+
+	return routePattern{
+		raw:      pattern,
+		segments: segments,
+	}
+}
+
+func (p routePattern) match(path string) (RouteParams, bool) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, false
+	}
+	pathSegments := strings.Split(path[1:], "/")
+
+	var params RouteParams
+	for i, s := range p.segments {
+		if i >= len(pathSegments) {
+			return nil, false
+		}
+
+		switch {
+		case s.wildcard:
+			if params == nil {
+				params = make(RouteParams)
+			}
+			params[s.value] = strings.Join(pathSegments[i:], "/")
+			return params, true
+
+		case s.param:
+			if pathSegments[i] == "" {
+				return nil, false
+			}
+			if params == nil {
+				params = make(RouteParams)
+			}
+			params[s.value] = pathSegments[i]
+
+		case s.value != pathSegments[i]:
+			return nil, false
+		}
+	}
+
+	if len(pathSegments) != len(p.segments) {
+		return nil, false
+	}
+	return params, true
+}
+
+func (p routePattern) url(params RouteParams) (string, error) {
+	var b strings.Builder
+	for _, s := range p.segments {
+		b.WriteByte('/')
+
+		if !s.param {
+			b.WriteString(s.value)
+			continue
+		}
+
+		v, ok := params[s.value]
+		if !ok || (v == "" && !s.wildcard) {
+			return "", errors.New("missing route parameter").WithTag("param", s.value)
+		}
+
+		if !s.wildcard {
+			b.WriteString(url.PathEscape(v))
+			continue
+		}
+
+		parts := strings.Split(v, "/")
+		for i, part := range parts {
+			parts[i] = url.PathEscape(part)
+		}
+		b.WriteString(strings.Join(parts, "/"))
+	}
+	return b.String(), nil
 }
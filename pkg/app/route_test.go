@@ -136,3 +136,201 @@ func TestRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestRoutesWithPattern(t *testing.T) {
+	utests := []struct {
+		scenario       string
+		createRoutes   func(*router)
+		path           string
+		expected       Composer
+		expectedParams RouteParams
+		notFound       bool
+	}{
+		{
+			scenario: "static pattern is routed",
+			path:     "/users",
+			createRoutes: func(r *router) {
+				r.routeWithPattern("", "/users", NewZeroComponentFactory(&routeCompo{}))
+			},
+			expected: &routeCompo{},
+		},
+		{
+			scenario: "pattern with parameter is routed",
+			path:     "/users/42",
+			createRoutes: func(r *router) {
+				r.routeWithPattern("", "/users/{id}", NewZeroComponentFactory(&routeCompo{}))
+			},
+			expected:       &routeCompo{},
+			expectedParams: RouteParams{"id": "42"},
+		},
+		{
+			scenario: "pattern with multiple parameters is routed",
+			path:     "/users/42/posts/hello",
+			createRoutes: func(r *router) {
+				r.routeWithPattern("", "/users/{id}/posts/{slug}", NewZeroComponentFactory(&routeCompo{}))
+			},
+			expected:       &routeCompo{},
+			expectedParams: RouteParams{"id": "42", "slug": "hello"},
+		},
+		{
+			scenario: "pattern with wildcard is routed",
+			path:     "/users/42/files/foo/bar.png",
+			createRoutes: func(r *router) {
+				r.routeWithPattern("", "/users/{id}/files/{path...}", NewZeroComponentFactory(&routeCompo{}))
+			},
+			expected:       &routeCompo{},
+			expectedParams: RouteParams{"id": "42", "path": "foo/bar.png"},
+		},
+		{
+			scenario: "pattern with wildcard is routed with empty remainder",
+			path:     "/files/",
+			createRoutes: func(r *router) {
+				r.routeWithPattern("", "/files/{path...}", NewZeroComponentFactory(&routeCompo{}))
+			},
+			expected:       &routeCompo{},
+			expectedParams: RouteParams{"path": ""},
+		},
+		{
+			scenario: "pattern with wildcard is not routed without trailing slash",
+			path:     "/files",
+			createRoutes: func(r *router) {
+				r.routeWithPattern("", "/files/{path...}", NewZeroComponentFactory(&routeCompo{}))
+			},
+			notFound: true,
+		},
+		{
+			scenario: "pattern with empty parameter is not routed",
+			path:     "/users/",
+			createRoutes: func(r *router) {
+				r.routeWithPattern("", "/users/{id}", NewZeroComponentFactory(&routeCompo{}))
+			},
+			notFound: true,
+		},
+		{
+			scenario: "pattern with extra segment is not routed",
+			path:     "/users/42/settings",
+			createRoutes: func(r *router) {
+				r.routeWithPattern("", "/users/{id}", NewZeroComponentFactory(&routeCompo{}))
+			},
+			notFound: true,
+		},
+		{
+			scenario: "path takes priority over pattern",
+			path:     "/users/new",
+			createRoutes: func(r *router) {
+				r.routeWithPattern("", "/users/{id}", NewZeroComponentFactory(&routeWithRegexpCompo{}))
+				r.route("/users/new", NewZeroComponentFactory(&routeCompo{}))
+			},
+			expected: &routeCompo{},
+		},
+		{
+			scenario: "pattern takes priority over regexp",
+			path:     "/users/42",
+			createRoutes: func(r *router) {
+				r.routeWithRegexp("^/users/.*$", NewZeroComponentFactory(&routeWithRegexpCompo{}))
+				r.routeWithPattern("", "/users/{id}", NewZeroComponentFactory(&routeCompo{}))
+			},
+			expected:       &routeCompo{},
+			expectedParams: RouteParams{"id": "42"},
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			r := makeRouter()
+			if u.createRoutes != nil {
+				u.createRoutes(&r)
+			}
+
+			match, routed := r.match(u.path)
+			if u.notFound {
+				require.False(t, routed)
+				return
+			}
+
+			require.True(t, routed)
+			require.Equal(t, reflect.TypeOf(u.expected), reflect.TypeOf(match.newComponent()))
+			require.Equal(t, u.expectedParams, match.params)
+		})
+	}
+}
+
+func TestParseRoutePatternPanics(t *testing.T) {
+	patterns := []string{
+		"users/{id}",
+		"/users/{id",
+		"/users/id}",
+		"/users/{}",
+		"/files/{path...}/edit",
+		"/users/{id}/posts/{id}",
+	}
+
+	for _, p := range patterns {
+		t.Run(p, func(t *testing.T) {
+			require.Panics(t, func() {
+				parseRoutePattern(p)
+			})
+		})
+	}
+}
+
+func TestRouterURL(t *testing.T) {
+	r := makeRouter()
+	r.routeWithPattern("home", "/", NewZeroComponentFactory(&routeCompo{}))
+	r.routeWithPattern("user", "/users/{id}", NewZeroComponentFactory(&routeCompo{}))
+	r.routeWithPattern("file", "/files/{path...}", NewZeroComponentFactory(&routeCompo{}))
+
+	t.Run("static route", func(t *testing.T) {
+		u, err := r.url("home", nil)
+		require.NoError(t, err)
+		require.Equal(t, "/", u)
+	})
+
+	t.Run("route with parameter", func(t *testing.T) {
+		u, err := r.url("user", RouteParams{"id": "42 a"})
+		require.NoError(t, err)
+		require.Equal(t, "/users/42%20a", u)
+	})
+
+	t.Run("route with wildcard", func(t *testing.T) {
+		u, err := r.url("file", RouteParams{"path": "foo/bar baz.png"})
+		require.NoError(t, err)
+		require.Equal(t, "/files/foo/bar%20baz.png", u)
+	})
+
+	t.Run("route with missing parameter returns an error", func(t *testing.T) {
+		_, err := r.url("user", nil)
+		require.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("unknown route returns an error", func(t *testing.T) {
+		_, err := r.url("unknown", nil)
+		require.Error(t, err)
+		t.Log(err)
+	})
+}
+
+func TestRouterMatchRoute(t *testing.T) {
+	r := makeRouter()
+	r.route("/hello", NewZeroComponentFactory(&routeCompo{}))
+	r.routeWithPattern("", "/users/{id}", NewZeroComponentFactory(&routeCompo{}))
+	r.routeWithRegexp("^/posts/[0-9]+$", NewZeroComponentFactory(&routeWithRegexpCompo{}))
+
+	utests := []struct {
+		path  string
+		route string
+	}{
+		{path: "/hello", route: "/hello"},
+		{path: "/users/42", route: "/users/{id}"},
+		{path: "/posts/42", route: "^/posts/[0-9]+$"},
+	}
+
+	for _, u := range utests {
+		t.Run(u.path, func(t *testing.T) {
+			match, routed := r.match(u.path)
+			require.True(t, routed)
+			require.Equal(t, u.route, match.route)
+		})
+	}
+}
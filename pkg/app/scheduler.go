@@ -0,0 +1,131 @@
+package app
+
+import "sync"
+
+// Priority represents the urgency of a function dispatched on the UI goroutine.
+type Priority int
+
+const (
+	// NormalPriority is the priority of functions dispatched with
+	// Context.Dispatch. They are executed after the ones with
+	// UserInputPriority.
+	NormalPriority Priority = iota
+
+	// UserInputPriority is the priority of HTML event handlers and browser
+	// navigation. They are executed before any other dispatched function,
+	// keeping the app responsive while heavier work is pending.
+	UserInputPriority
+
+	// IdlePriority is the priority of work that can wait for the browser to be
+	// idle, such as prefetching or analytics. Functions with IdlePriority are
+	// executed when no other function or component update is pending, during
+	// the idle periods reported by requestIdleCallback.
+	IdlePriority
+)
+
+// scheduler is an unbounded queue of functions to execute on the UI goroutine,
+// ordered by priority. Functions with the same priority are executed in the
+// order they were pushed.
+type scheduler struct {
+	lanes [3]taskQueue
+	ready chan struct{}
+}
+
+func newScheduler() *scheduler {
+	return &scheduler{
+		ready: make(chan struct{}, 1),
+	}
+}
+
+// Push queues the given function with the given priority. It never blocks.
+func (s *scheduler) Push(p Priority, f func()) {
+	s.lane(p).Push(f)
+	s.Signal()
+}
+
+// Signal notifies the channel returned by Ready, without blocking.
+func (s *scheduler) Signal() {
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Ready returns a channel that receives a value when functions are pushed.
+func (s *scheduler) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Pop removes and returns the most urgent queued function. Functions with
+// IdlePriority are only returned when idle is true.
+func (s *scheduler) Pop(idle bool) (func(), bool) {
+	if f, ok := s.lane(UserInputPriority).Pop(); ok {
+		return f, true
+	}
+	if f, ok := s.lane(NormalPriority).Pop(); ok {
+		return f, true
+	}
+	if idle {
+		return s.lane(IdlePriority).Pop()
+	}
+	return nil, false
+}
+
+// Urgent reports whether functions without IdlePriority are queued.
+func (s *scheduler) Urgent() bool {
+	return s.lane(UserInputPriority).Len() != 0 || s.lane(NormalPriority).Len() != 0
+}
+
+// Len returns the number of queued functions.
+func (s *scheduler) Len() int {
+	var n int
+	for i := range s.lanes {
+		n += s.lanes[i].Len()
+	}
+	return n
+}
+
+func (s *scheduler) lane(p Priority) *taskQueue {
+	switch p {
+	case UserInputPriority:
+		return &s.lanes[1]
+
+	case IdlePriority:
+		return &s.lanes[2]
+
+	default:
+		return &s.lanes[0]
+	}
+}
+
+// taskQueue is an unbounded first-in first-out queue of functions, safe for
+// concurrent use.
+type taskQueue struct {
+	mutex sync.Mutex
+	tasks []func()
+}
+
+func (q *taskQueue) Push(f func()) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.tasks = append(q.tasks, f)
+}
+
+func (q *taskQueue) Pop() (func(), bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.tasks) == 0 {
+		return nil, false
+	}
+	f := q.tasks[0]
+	q.tasks[0] = nil
+	q.tasks = q.tasks[1:]
+	return f, true
+}
+
+func (q *taskQueue) Len() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.tasks)
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Run("functions are popped by priority", func(t *testing.T) {
+		s := newScheduler()
+
+		var calls []string
+		push := func(p Priority, name string) {
+			s.Push(p, func() {
+				calls = append(calls, name)
+			})
+		}
+		push(IdlePriority, "idle")
+		push(NormalPriority, "normal-1")
+		push(UserInputPriority, "input-1")
+		push(NormalPriority, "normal-2")
+		push(UserInputPriority, "input-2")
+		require.Equal(t, 5, s.Len())
+		require.True(t, s.Urgent())
+
+		for {
+			f, ok := s.Pop(false)
+			if !ok {
+				break
+			}
+			f()
+		}
+		require.Equal(t, []string{"input-1", "input-2", "normal-1", "normal-2"}, calls)
+		require.False(t, s.Urgent())
+		require.Equal(t, 1, s.Len())
+
+		f, ok := s.Pop(true)
+		require.True(t, ok)
+		f()
+		require.Equal(t, "idle", calls[len(calls)-1])
+		require.Zero(t, s.Len())
+
+		_, ok = s.Pop(true)
+		require.False(t, ok)
+	})
+
+	t.Run("push does not block", func(t *testing.T) {
+		s := newScheduler()
+
+		for i := 0; i < 10000; i++ {
+			s.Push(NormalPriority, func() {})
+		}
+		require.Equal(t, 10000, s.Len())
+		require.Len(t, s.Ready(), 1)
+	})
+}
+
+func TestTaskQueue(t *testing.T) {
+	var q taskQueue
+
+	_, ok := q.Pop()
+	require.False(t, ok)
+
+	var calls []int
+	q.Push(func() { calls = append(calls, 1) })
+	q.Push(func() { calls = append(calls, 2) })
+	require.Equal(t, 2, q.Len())
+
+	for f, ok := q.Pop(); ok; f, ok = q.Pop() {
+		f()
+	}
+	require.Equal(t, []int{1, 2}, calls)
+	require.Zero(t, q.Len())
+}
@@ -0,0 +1,285 @@
+package app
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Reactive is the interface that describes a value whose changes can be
+// observed, such as a Signal or a Computed value.
+type Reactive interface {
+	reactive() *signalNode
+}
+
+// Signal is a value that updates the components that depend on it when it
+// changes. A component depends on a signal when it reads it with Track in its
+// Render method. Unlike a component field change, a signal change only updates
+// the components that depend on it, leaving their parents untouched.
+//
+// Signals are safe for concurrent use.
+//
+// Example:
+//
+//	type counter struct {
+//	    app.Compo
+//
+//	    count *app.Signal[int]
+//	}
+//
+//	func (c *counter) OnInit() {
+//	    c.count = app.NewSignal(0)
+//	}
+//
+//	func (c *counter) Render() app.UI {
+//	    return app.Button().
+//	        Text(c.count.Track(c)).
+//	        OnClick(func(ctx app.Context, e app.Event) {
+//	            c.count.Update(ctx, func(v int) int { return v + 1 })
+//	        })
+//	}
+type Signal[T any] struct {
+	node  signalNode
+	mutex sync.RWMutex
+	value T
+}
+
+// NewSignal creates a signal with the given initial value.
+func NewSignal[T any](v T) *Signal[T] {
+	return &Signal[T]{value: v}
+}
+
+// Get returns the signal value without making the caller depend on it.
+func (s *Signal[T]) Get() T {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.value
+}
+
+// Track returns the signal value and makes the given component depend on it.
+// It is meant to be called from the component Render method.
+func (s *Signal[T]) Track(c Composer) T {
+	s.node.track(c)
+	return s.Get()
+}
+
+// Set sets the signal value and schedules the update of the components that
+// depend on it, directly or through computed values. Setting a comparable
+// value equal to the current one has no effect.
+func (s *Signal[T]) Set(ctx Context, v T) {
+	s.Update(ctx, func(T) T {
+		return v
+	})
+}
+
+// Update sets the signal value to the result of the given function, which is
+// called with the current value, and schedules the update of the components
+// that depend on it.
+func (s *Signal[T]) Update(ctx Context, f func(T) T) {
+	s.mutex.Lock()
+	old := s.value
+	s.value = f(old)
+	changed := !signalValuesEqual(old, s.value)
+	s.mutex.Unlock()
+
+	if changed {
+		s.node.notify(ctx)
+	}
+}
+
+func (s *Signal[T]) reactive() *signalNode {
+	return &s.node
+}
+
+// Computed is a value derived from signals or other computed values. It is
+// computed when read and memoized until one of its dependencies changes.
+//
+// Computed values are safe for concurrent use.
+type Computed[T any] struct {
+	node         signalNode
+	mutex        sync.Mutex
+	compute      func() T
+	dependencies []Reactive
+	value        T
+	valid        bool
+	generation   uint64
+}
+
+// NewComputed creates a value computed with the given function from the given
+// dependencies.
+//
+// Example:
+//
+//	total := app.NewComputed(func() float64 {
+//	    return price.Get() * float64(quantity.Get())
+//	}, price, quantity)
+func NewComputed[T any](compute func() T, dependencies ...Reactive) *Computed[T] {
+	c := &Computed[T]{
+		compute:      compute,
+		dependencies: dependencies,
+	}
+	c.node.invalidate = c.invalidate
+	return c
+}
+
+// Get returns the computed value without making the caller depend on it. The
+// value is computed only when one of its dependencies changed since the last
+// computation.
+func (c *Computed[T]) Get() T {
+	c.mutex.Lock()
+	if c.valid {
+		defer c.mutex.Unlock()
+		return c.value
+	}
+
+	// Subscribing before computing ensures that a dependency changing during
+	// the computation invalidates its result.
+	for _, d := range c.dependencies {
+		d.reactive().addDependent(&c.node)
+	}
+	generation := c.generation
+	c.mutex.Unlock()
+
+	// The computation runs unlocked, which allows it to set its own
+	// dependencies. Its result is then only memoized when no dependency
+	// changed in the meantime.
+	v := c.compute()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.generation == generation {
+		c.value = v
+		c.valid = true
+	}
+	return v
+}
+
+// Track returns the computed value and makes the given component depend on
+// it. It is meant to be called from the component Render method.
+func (c *Computed[T]) Track(compo Composer) T {
+	c.node.track(compo)
+	return c.Get()
+}
+
+func (c *Computed[T]) reactive() *signalNode {
+	return &c.node
+}
+
+func (c *Computed[T]) invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.generation++
+	c.valid = false
+	var zero T
+	c.value = zero
+}
+
+// signalNode tracks the components and computed values that depend on a
+// reactive value. Dependencies are dropped once notified and are established
+// again when the value is read, which releases the dependents that stop
+// reading it.
+type signalNode struct {
+	mutex      sync.Mutex
+	components map[Composer]struct{}
+	dependents map[*signalNode]struct{}
+	invalidate func()
+}
+
+func (n *signalNode) track(c Composer) {
+	n.mutex.Lock()
+	if n.components == nil {
+		n.components = make(map[Composer]struct{})
+	}
+	n.components[c] = struct{}{}
+	n.mutex.Unlock()
+
+	c.trackSignal(n)
+}
+
+func (n *signalNode) untrack(c Composer) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	delete(n.components, c)
+}
+
+func (n *signalNode) addDependent(d *signalNode) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if n.dependents == nil {
+		n.dependents = make(map[*signalNode]struct{})
+	}
+	n.dependents[d] = struct{}{}
+}
+
+// notify invalidates the computed values that depend on the node and
+// schedules the update of the components that depend on it, directly or
+// through computed values.
+func (n *signalNode) notify(ctx Context) {
+	components := make(map[Composer]struct{})
+	n.collect(components)
+	if len(components) == 0 {
+		return
+	}
+
+	ctx.dispatch(NormalPriority, func() {
+		for c := range components {
+			if c.Mounted() {
+				ctx.addComponentUpdate(c)
+			}
+		}
+	})
+}
+
+func (n *signalNode) collect(components map[Composer]struct{}) {
+	n.mutex.Lock()
+	for c := range n.components {
+		components[c] = struct{}{}
+	}
+	dependents := n.dependents
+	n.components = nil
+	n.dependents = nil
+	n.mutex.Unlock()
+
+	for d := range dependents {
+		if d.invalidate != nil {
+			d.invalidate()
+		}
+		d.collect(components)
+	}
+}
+
+// untrackSignals removes the dependencies of the components within the given
+// element tree on signals and computed values.
+func untrackSignals(v UI) {
+	switch v := v.(type) {
+	case HTML:
+		for _, child := range v.body() {
+			untrackSignals(child)
+		}
+
+	case Composer:
+		untrackComponentSignals(v)
+		untrackSignals(v.root())
+
+	case *portal:
+		untrackSignals(v.container)
+	}
+}
+
+func untrackComponentSignals(v Composer) {
+	for _, s := range v.releaseSignals() {
+		s.untrack(v)
+	}
+}
+
+func signalValuesEqual(a, b any) bool {
+	va := reflect.ValueOf(a)
+	if !va.IsValid() {
+		return b == nil
+	}
+	if !va.Comparable() {
+		return false
+	}
+	return a == b
+}
@@ -0,0 +1,175 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type signalParentCompo struct {
+	Compo
+
+	child   *signalChildCompo
+	renders int
+}
+
+func (c *signalParentCompo) Render() UI {
+	c.renders++
+	return Div().Body(c.child)
+}
+
+type signalChildCompo struct {
+	Compo
+
+	count   *Signal[int]
+	double  *Computed[int]
+	renders int
+}
+
+func (c *signalChildCompo) Render() UI {
+	c.renders++
+	return Span().Text(c.double.Track(c))
+}
+
+func newSignalChildCompo() *signalChildCompo {
+	count := NewSignal(1)
+	return &signalChildCompo{
+		count: count,
+		double: NewComputed(func() int {
+			return count.Get() * 2
+		}, count),
+	}
+}
+
+func TestSignal(t *testing.T) {
+	t.Run("set updates value", func(t *testing.T) {
+		e := newTestEngine()
+		s := NewSignal("hello")
+		require.Equal(t, "hello", s.Get())
+
+		s.Set(e.baseContext(), "bye")
+		require.Equal(t, "bye", s.Get())
+
+		s.Update(e.baseContext(), func(v string) string {
+			return v + "!"
+		})
+		require.Equal(t, "bye!", s.Get())
+	})
+
+	t.Run("change updates only dependent components", func(t *testing.T) {
+		e := newTestEngine()
+		child := newSignalChildCompo()
+		parent := &signalParentCompo{child: child}
+		require.NoError(t, e.Load(parent))
+		e.ConsumeAll()
+		require.NoError(t, Match(Text(2), parent, 0, 0, 0, 0))
+
+		parentRenders := parent.renders
+		childRenders := child.renders
+
+		child.count.Set(e.baseContext(), 21)
+		e.ConsumeAll()
+		require.NoError(t, Match(Text(42), parent, 0, 0, 0, 0))
+		require.Equal(t, parentRenders, parent.renders)
+		require.Equal(t, childRenders+1, child.renders)
+	})
+
+	t.Run("setting same value does not update components", func(t *testing.T) {
+		e := newTestEngine()
+		child := newSignalChildCompo()
+		require.NoError(t, e.Load(child))
+		e.ConsumeAll()
+		renders := child.renders
+
+		child.count.Set(e.baseContext(), 1)
+		e.ConsumeAll()
+		require.Equal(t, renders, child.renders)
+	})
+
+	t.Run("dismounted component is not updated", func(t *testing.T) {
+		e := newTestEngine()
+		child := newSignalChildCompo()
+		require.NoError(t, e.Load(child))
+		e.ConsumeAll()
+
+		renders := child.renders
+		e.nodes.Dismount(child)
+		require.Empty(t, child.double.node.components)
+
+		child.count.Set(e.baseContext(), 2)
+		e.ConsumeAll()
+		require.Equal(t, renders, child.renders)
+	})
+
+	t.Run("untracking element tree releases components", func(t *testing.T) {
+		e := newTestEngine()
+		child := newSignalChildCompo()
+		parent := &signalParentCompo{child: child}
+		require.NoError(t, e.Load(parent))
+		e.ConsumeAll()
+		require.Len(t, child.double.node.components, 1)
+
+		untrackSignals(e.body)
+		require.Empty(t, child.double.node.components)
+		require.Empty(t, child.signals)
+	})
+}
+
+func TestComputed(t *testing.T) {
+	e := newTestEngine()
+	a := NewSignal(1)
+	b := NewSignal(2)
+
+	sums := 0
+	sum := NewComputed(func() int {
+		sums++
+		return a.Get() + b.Get()
+	}, a, b)
+
+	squares := 0
+	square := NewComputed(func() int {
+		squares++
+		v := sum.Get()
+		return v * v
+	}, sum)
+
+	t.Run("value is memoized", func(t *testing.T) {
+		require.Equal(t, 9, square.Get())
+		require.Equal(t, 9, square.Get())
+		require.Equal(t, 3, sum.Get())
+		require.Equal(t, 1, sums)
+		require.Equal(t, 1, squares)
+	})
+
+	t.Run("value is computed again when a dependency changes", func(t *testing.T) {
+		a.Set(e.baseContext(), 2)
+		require.Equal(t, 16, square.Get())
+		require.Equal(t, 2, sums)
+		require.Equal(t, 2, squares)
+	})
+
+	t.Run("computation can set its own dependency", func(t *testing.T) {
+		count := NewSignal(1)
+		computes := 0
+		clamped := NewComputed(func() int {
+			computes++
+			v := count.Get()
+			if v < 3 {
+				count.Set(e.baseContext(), 3)
+			}
+			return v
+		}, count)
+
+		require.Equal(t, 1, clamped.Get())
+		require.Equal(t, 3, clamped.Get())
+		require.Equal(t, 3, clamped.Get())
+		require.Equal(t, 2, computes)
+	})
+}
+
+func TestSignalValuesEqual(t *testing.T) {
+	require.True(t, signalValuesEqual(42, 42))
+	require.False(t, signalValuesEqual(42, 21))
+	require.True(t, signalValuesEqual(nil, nil))
+	require.False(t, signalValuesEqual([]int{42}, []int{42}))
+}
@@ -4,6 +4,8 @@
 package app
 
 import (
+	"bytes"
+	"html"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -18,6 +20,14 @@ import (
 // static website in the specified directory. Static websites can be used with
 // hosts such as Github Pages.
 //
+// Pages are written with the content served by the handler, whatever their
+// status code. Redirected pages are written as pages that redirect to their
+// destination and a 404.html page, displayed by most static hosts when a file
+// does not exist, is generated from the not found page.
+//
+// The scoped styles of the components mounted while generating the pages are
+// appended to app.css.
+//
 // Note that app.wasm must still be built separately and put into the web
 // directory.
 func GenerateStaticWebsite(dir string, h *Handler, pages ...string) error {
@@ -31,8 +41,8 @@ func GenerateStaticWebsite(dir string, h *Handler, pages ...string) error {
 		"/app.js":               {},
 		"/app-worker.js":        {},
 		"/manifest.webmanifest": {},
-		"/app.css":              {},
 		"/web":                  {},
+		"/404.html":             {},
 	}
 
 	for path := range routes.routes {
@@ -92,6 +102,31 @@ func GenerateStaticWebsite(dir string, h *Handler, pages ...string) error {
 		}
 	}
 
+	return generateStaticAppCSS(dir, server.URL, h)
+}
+
+// generateStaticAppCSS writes app.css with the scoped styles of the components
+// mounted while generating the pages appended.
+func generateStaticAppCSS(dir, serverURL string, h *Handler) error {
+	f, err := createStaticFile(dir, "/app.css")
+	if err != nil {
+		return errors.New("creating file failed").
+			WithTag("path", "/app.css").
+			Wrap(err)
+	}
+	defer f.Close()
+
+	css, err := createStaticPage(serverURL + "/app.css")
+	if err != nil {
+		return errors.New("creating app.css failed").Wrap(err)
+	}
+	css = append(css, h.componentStyles.CSS()...)
+
+	if n, err := f.Write(css); err != nil {
+		return errors.New("writing app.css failed").
+			WithTag("bytes-written", n).
+			Wrap(err)
+	}
 	return nil
 }
 
@@ -124,7 +159,13 @@ func createStaticPage(path string) ([]byte, error) {
 			Wrap(err)
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	client := http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := client.Do(req)
 	if err != nil {
 		return nil, errors.New("http request failed").
 			WithTag("path", path).
@@ -132,6 +173,10 @@ func createStaticPage(path string) ([]byte, error) {
 	}
 	defer res.Body.Close()
 
+	if location := res.Header.Get("Location"); location != "" && isRedirectStatus(res.StatusCode) {
+		return createStaticRedirectPage(location), nil
+	}
+
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return nil, errors.New("reading request body failed").
@@ -140,3 +185,20 @@ func createStaticPage(path string) ([]byte, error) {
 	}
 	return body, nil
 }
+
+func isRedirectStatus(v int) bool {
+	return v >= http.StatusMultipleChoices && v < http.StatusBadRequest
+}
+
+func createStaticRedirectPage(location string) []byte {
+	location = html.EscapeString(location)
+
+	var b bytes.Buffer
+	b.WriteString("<!DOCTYPE html>\n")
+	b.WriteString(`<html><head><meta http-equiv="refresh" content="0; url=`)
+	b.WriteString(location)
+	b.WriteString(`"><link rel="canonical" href="`)
+	b.WriteString(location)
+	b.WriteString(`"></head></html>`)
+	return b.Bytes()
+}
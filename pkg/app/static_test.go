@@ -41,6 +41,7 @@ func TestGenerateStaticWebsite(t *testing.T) {
 		filepath.Join(dir, "hello.html"),
 		filepath.Join(dir, "world.html"),
 		filepath.Join(dir, "nested", "foo.html"),
+		filepath.Join(dir, "404.html"),
 	}
 
 	for _, f := range files {
@@ -49,4 +50,22 @@ func TestGenerateStaticWebsite(t *testing.T) {
 			require.NoError(t, err)
 		})
 	}
+
+	t.Run("not found page", func(t *testing.T) {
+		b, err := os.ReadFile(filepath.Join(dir, "404.html"))
+		require.NoError(t, err)
+		require.Contains(t, string(b), "goapp-notfound-title")
+	})
+
+	t.Run("app.css contains component styles", func(t *testing.T) {
+		b, err := os.ReadFile(filepath.Join(dir, "app.css"))
+		require.NoError(t, err)
+		require.Contains(t, string(b), componentStyleOf(&styledTestCompo{}).css)
+	})
+
+	t.Run("redirected page", func(t *testing.T) {
+		b, err := os.ReadFile(filepath.Join(dir, "guarded", "redirect.html"))
+		require.NoError(t, err)
+		require.Contains(t, string(b), `<meta http-equiv="refresh" content="0; url=/signin">`)
+	})
 }
@@ -0,0 +1,375 @@
+package app
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// The attribute that identifies the HTML elements rendered by a component
+	// with scoped styles.
+	styleScopeAttr = "data-goapp-scope"
+)
+
+// Styler is the interface that describes a component with scoped styles.
+//
+// Styles are injected into the page the first time a component of the given
+// type is mounted, and are emitted into the page head when the component is
+// pre-rendered on the server. They are also collected into app.css by
+// GenerateStaticWebsite.
+type Styler interface {
+	Composer
+
+	// Returns the CSS of the component. It is called once per component type.
+	//
+	// Selectors only match the HTML elements rendered by the component,
+	// excluding the ones rendered by nested components. A selector wrapped
+	// into :global() is not scoped:
+	//
+	//	func (b *button) Styles() string {
+	//	    return `
+	//	        button { padding: 6px 12px; }
+	//	        button:hover { opacity: 0.8; }
+	//	        :global(.dark) button { color: white; }
+	//	    `
+	//	}
+	Styles() string
+}
+
+// componentStyle represents the scoped styles of a component type.
+type componentStyle struct {
+	scope string
+	css   string
+}
+
+var componentStyles sync.Map
+
+// componentStyleOf returns the scoped styles of the type of the given
+// component.
+func componentStyleOf(v Styler) componentStyle {
+	t := reflect.TypeOf(v)
+	if s, ok := componentStyles.Load(t); ok {
+		return s.(componentStyle)
+	}
+
+	scope := styleScope(t)
+	s := componentStyle{
+		scope: scope,
+		css:   scopeCSS(v.Styles(), scope),
+	}
+	componentStyles.Store(t, s)
+	return s
+}
+
+// styleScope returns the scope of the styles of the given component type. It
+// is derived from the type name in order to be the same on the server and in
+// the browser.
+func styleScope(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(t.PkgPath() + "." + t.Name()))
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// scopeElements sets the style scope attribute on the given element and its
+// descendants, excluding the ones rendered by nested components.
+func scopeElements(v UI, scope string) {
+	switch v := v.(type) {
+	case HTML:
+		attrs := v.attrs()
+		if attrs == nil {
+			attrs = make(attributes)
+			v.setAttrs(attrs)
+		}
+		attrs[styleScopeAttr] = scope
+
+		for _, child := range v.body() {
+			scopeElements(child, scope)
+		}
+
+	case *portal:
+		for _, child := range v.container.body() {
+			scopeElements(child, scope)
+		}
+	}
+}
+
+// styleManager tracks the component styles used by an engine.
+type styleManager struct {
+	mutex  sync.Mutex
+	styles map[string]string
+}
+
+// Add records the given component styles. It returns false when they were
+// already recorded.
+func (m *styleManager) Add(s componentStyle) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.styles[s.scope]; ok {
+		return false
+	}
+	if m.styles == nil {
+		m.styles = make(map[string]string)
+	}
+	m.styles[s.scope] = s.css
+	return true
+}
+
+// List returns the recorded component styles, sorted by scope.
+func (m *styleManager) List() []componentStyle {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	styles := make([]componentStyle, 0, len(m.styles))
+	for scope, css := range m.styles {
+		styles = append(styles, componentStyle{
+			scope: scope,
+			css:   css,
+		})
+	}
+	sort.Slice(styles, func(a, b int) bool {
+		return styles[a].scope < styles[b].scope
+	})
+	return styles
+}
+
+// CSS returns the recorded component styles as a single stylesheet.
+func (m *styleManager) CSS() string {
+	var b strings.Builder
+	for _, s := range m.List() {
+		b.WriteString(s.css)
+	}
+	return b.String()
+}
+
+// scopeCSS rewrites the selectors of the given CSS to only match the elements
+// with the given style scope.
+func scopeCSS(css, scope string) string {
+	var b strings.Builder
+	scopeCSSRules(&b, stripCSSComments(css), `[`+styleScopeAttr+`="`+scope+`"]`)
+	return b.String()
+}
+
+func scopeCSSRules(b *strings.Builder, css, attr string) {
+	for {
+		css = strings.TrimSpace(css)
+		if css == "" {
+			return
+		}
+
+		i := cssIndex(css, "{;")
+		if i < 0 {
+			b.WriteString(css)
+			b.WriteByte('\n')
+			return
+		}
+
+		prelude := strings.TrimSpace(css[:i])
+		if css[i] == ';' {
+			b.WriteString(prelude)
+			b.WriteString(";\n")
+			css = css[i+1:]
+			continue
+		}
+
+		end := cssBlockEnd(css, i)
+		block := css[i+1 : end]
+		if end < len(css) {
+			end++
+		}
+		css = css[end:]
+
+		switch {
+		case isNestedCSSAtRule(prelude):
+			b.WriteString(prelude)
+			b.WriteString(" {\n")
+			scopeCSSRules(b, block, attr)
+			b.WriteString("}\n")
+
+		case strings.HasPrefix(prelude, "@"):
+			b.WriteString(prelude)
+			b.WriteString(" {")
+			b.WriteString(block)
+			b.WriteString("}\n")
+
+		default:
+			b.WriteString(scopeCSSSelectors(prelude, attr))
+			b.WriteString(" { ")
+			b.WriteString(strings.TrimSpace(block))
+			b.WriteString(" }\n")
+		}
+	}
+}
+
+func isNestedCSSAtRule(prelude string) bool {
+	for _, rule := range []string{"@media", "@supports", "@container", "@layer", "@document"} {
+		if strings.HasPrefix(prelude, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+func scopeCSSSelectors(selectors, attr string) string {
+	var scoped []string
+	for {
+		i := cssIndex(selectors, ",")
+		if i < 0 {
+			break
+		}
+		scoped = append(scoped, scopeCSSSelector(strings.TrimSpace(selectors[:i]), attr))
+		selectors = selectors[i+1:]
+	}
+	scoped = append(scoped, scopeCSSSelector(strings.TrimSpace(selectors), attr))
+	return strings.Join(scoped, ", ")
+}
+
+// scopeCSSSelector adds the given attribute selector to the last compound
+// selector of the given selector, before its pseudo-classes and
+// pseudo-elements.
+func scopeCSSSelector(selector, attr string) string {
+	start := 0
+	for i := 0; i < len(selector); {
+		j := cssIndex(selector[i:], " \t\n>+~")
+		if j < 0 {
+			break
+		}
+		i += j + 1
+		start = i
+	}
+
+	compound := selector[start:]
+	if !strings.HasPrefix(compound, ":global(") {
+		if i := cssIndex(compound, ":"); i >= 0 {
+			selector = selector[:start+i] + attr + compound[i:]
+		} else {
+			selector += attr
+		}
+	}
+	return unwrapCSSGlobal(selector)
+}
+
+// unwrapCSSGlobal replaces the :global() pseudo-classes of the given selector
+// by their content.
+func unwrapCSSGlobal(selector string) string {
+	for {
+		i := strings.Index(selector, ":global(")
+		if i < 0 {
+			return selector
+		}
+
+		content := selector[i+len(":global("):]
+		end := cssIndex(content, ")")
+		if end < 0 {
+			return selector
+		}
+		selector = selector[:i] + content[:end] + content[end+1:]
+	}
+}
+
+// cssIndex returns the index of the first byte of s that is one of the given
+// chars, outside of strings, parentheses and brackets. It returns -1 when there
+// is none.
+func cssIndex(s, chars string) int {
+	var depth int
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+
+		case c == '"' || c == '\'':
+			quote = c
+
+		case depth == 0 && strings.IndexByte(chars, c) >= 0:
+			return i
+
+		case c == '(' || c == '[':
+			depth++
+
+		case c == ')' || c == ']':
+			if depth > 0 {
+				depth--
+			}
+		}
+	}
+	return -1
+}
+
+// cssBlockEnd returns the index of the brace that closes the block opened at
+// the given index, or the length of css when the block is not closed.
+func cssBlockEnd(css string, open int) int {
+	var depth int
+	var quote byte
+
+	for i := open; i < len(css); i++ {
+		c := css[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+
+		case c == '"' || c == '\'':
+			quote = c
+
+		case c == '{':
+			depth++
+
+		case c == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(css)
+}
+
+func stripCSSComments(css string) string {
+	var b strings.Builder
+	var quote byte
+
+	for i := 0; i < len(css); i++ {
+		c := css[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && i+1 < len(css) {
+				b.WriteByte(c)
+				i++
+				c = css[i]
+			} else if c == quote {
+				quote = 0
+			}
+
+		case c == '"' || c == '\'':
+			quote = c
+
+		case c == '/' && i+1 < len(css) && css[i+1] == '*':
+			end := strings.Index(css[i+2:], "*/")
+			if end < 0 {
+				return b.String()
+			}
+			i += end + 3
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
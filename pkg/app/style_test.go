@@ -0,0 +1,177 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type styledTestCompo struct {
+	Compo
+
+	child UI
+}
+
+func (c *styledTestCompo) Styles() string {
+	return `.styled { color: deepskyblue; }`
+}
+
+func (c *styledTestCompo) Render() UI {
+	return Div().
+		Class("styled").
+		Body(
+			Span().Text("styled"),
+			c.child,
+		)
+}
+
+type otherStyledTestCompo struct {
+	Compo
+}
+
+func (c *otherStyledTestCompo) Styles() string {
+	return `p { margin: 0; }`
+}
+
+func (c *otherStyledTestCompo) Render() UI {
+	return P().Text("other")
+}
+
+func TestScopeCSS(t *testing.T) {
+	utests := []struct {
+		scenario string
+		css      string
+		expected string
+	}{
+		{
+			scenario: "selector",
+			css:      `.btn { color: red; }`,
+			expected: ".btn[data-goapp-scope=\"x\"] { color: red; }\n",
+		},
+		{
+			scenario: "selector list",
+			css:      `h1, h2 { margin: 0 }`,
+			expected: "h1[data-goapp-scope=\"x\"], h2[data-goapp-scope=\"x\"] { margin: 0 }\n",
+		},
+		{
+			scenario: "complex selector",
+			css:      `ul > li a:hover::before { content: "}"; }`,
+			expected: "ul > li a[data-goapp-scope=\"x\"]:hover::before { content: \"}\"; }\n",
+		},
+		{
+			scenario: "attribute selector",
+			css:      `input[type="text"] { border: 0; }`,
+			expected: "input[type=\"text\"][data-goapp-scope=\"x\"] { border: 0; }\n",
+		},
+		{
+			scenario: "pseudo-class with arguments",
+			css:      `li:not(.a, .b) { color: red; }`,
+			expected: "li[data-goapp-scope=\"x\"]:not(.a, .b) { color: red; }\n",
+		},
+		{
+			scenario: "global selector",
+			css:      `:global(body) { margin: 0; }`,
+			expected: "body { margin: 0; }\n",
+		},
+		{
+			scenario: "selector with global ancestor",
+			css:      `:global(.dark) .btn { color: white; }`,
+			expected: ".dark .btn[data-goapp-scope=\"x\"] { color: white; }\n",
+		},
+		{
+			scenario: "media query",
+			css:      `@media (max-width: 480px) { .btn { padding: 0; } }`,
+			expected: "@media (max-width: 480px) {\n.btn[data-goapp-scope=\"x\"] { padding: 0; }\n}\n",
+		},
+		{
+			scenario: "keyframes",
+			css:      `@keyframes spin { to { transform: rotate(360deg); } }`,
+			expected: "@keyframes spin { to { transform: rotate(360deg); } }\n",
+		},
+		{
+			scenario: "statement at-rule",
+			css:      `@import url("theme.css");`,
+			expected: "@import url(\"theme.css\");\n",
+		},
+		{
+			scenario: "comments",
+			css:      `/* .a { } */ .b { color: red; /* } */ }`,
+			expected: ".b[data-goapp-scope=\"x\"] { color: red; }\n",
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			require.Equal(t, u.expected, scopeCSS(u.css, "x"))
+		})
+	}
+}
+
+func TestStyleScope(t *testing.T) {
+	scope := styleScope(reflect.TypeOf(&styledTestCompo{}))
+	require.Len(t, scope, 8)
+	require.Equal(t, scope, styleScope(reflect.TypeOf(styledTestCompo{})))
+	require.NotEqual(t, scope, styleScope(reflect.TypeOf(&otherStyledTestCompo{})))
+}
+
+func TestStyleManager(t *testing.T) {
+	var m styleManager
+	require.True(t, m.Add(componentStyle{scope: "b", css: "b{}"}))
+	require.True(t, m.Add(componentStyle{scope: "a", css: "a{}"}))
+	require.False(t, m.Add(componentStyle{scope: "a", css: "a{}"}))
+
+	require.Equal(t, []componentStyle{
+		{scope: "a", css: "a{}"},
+		{scope: "b", css: "b{}"},
+	}, m.List())
+	require.Equal(t, "a{}b{}", m.CSS())
+}
+
+func TestStyledComponent(t *testing.T) {
+	t.Run("rendered elements are scoped", func(t *testing.T) {
+		e := newTestEngine()
+		compo := &styledTestCompo{child: &hello{}}
+		require.NoError(t, e.Load(compo))
+		e.ConsumeAll()
+
+		scope := componentStyleOf(compo).scope
+		root := compo.root().(HTML)
+		require.Equal(t, scope, root.attrs()[styleScopeAttr])
+		require.Equal(t, scope, root.body()[0].(HTML).attrs()[styleScopeAttr])
+
+		helloRoot := compo.child.(*hello).root().(HTML)
+		require.Empty(t, helloRoot.attrs()[styleScopeAttr])
+	})
+
+	t.Run("styles are added once per component type", func(t *testing.T) {
+		e := newTestEngine()
+		require.NoError(t, e.Load(&styledTestCompo{
+			child: Div().Body(
+				&styledTestCompo{},
+				&otherStyledTestCompo{},
+			),
+		}))
+		e.ConsumeAll()
+
+		styles := e.styles.List()
+		require.Len(t, styles, 2)
+		require.Contains(t, styles, componentStyleOf(&styledTestCompo{}))
+		require.Contains(t, styles, componentStyleOf(&otherStyledTestCompo{}))
+	})
+
+	t.Run("scope is kept on update", func(t *testing.T) {
+		e := newTestEngine()
+		compo := &styledTestCompo{}
+		require.NoError(t, e.Load(compo))
+		e.ConsumeAll()
+
+		compo.child = Span().Text("updated")
+		_, err := e.nodes.UpdateComponentRoot(e.baseContext(), compo)
+		require.NoError(t, err)
+
+		scope := componentStyleOf(compo).scope
+		child := compo.root().(HTML).body()[1].(HTML)
+		require.Equal(t, scope, child.attrs()[styleScopeAttr])
+	})
+}
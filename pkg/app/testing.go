@@ -27,6 +27,12 @@ type TestEngine interface {
 	// component's state is fully updated, allowing for accurate assertions and
 	// verifications in test scenarios.
 	ConsumeAll()
+
+	// ConsumeData advances the test engine's state by waiting for the data
+	// fetched with Context.FetchData and executing the operations that store it
+	// into components. It returns an error when the engine context is done
+	// before all the data is received.
+	ConsumeData() error
 }
 
 // NewTestEngine creates and returns a new instance of test engine configured
@@ -36,7 +42,7 @@ func NewTestEngine() TestEngine {
 	originPage := makeRequestPage(origin, nil)
 
 	routes := makeRouter()
-	return newEngine(context.Background(),
+	engine := newEngine(context.Background(),
 		&routes,
 		nil,
 		&originPage,
@@ -44,6 +50,8 @@ func NewTestEngine() TestEngine {
 			"/test": func(ctx Context, a Action) {},
 		},
 	)
+	engine.initBrowser()
+	return engine
 }
 
 // Match compares the expected UI element with another UI element at a specified
@@ -124,6 +132,9 @@ func TestMatch(root UI, d TestUIDescriptor) error {
 			}
 			d.Path = d.Path[1:]
 			return TestMatch(root.root(), d)
+
+		case *portal:
+			return TestMatch(root.container, d)
 		}
 	}
 
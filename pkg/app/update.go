@@ -41,6 +41,15 @@ func (m *updateManager) Done(v Composer) {
 	}
 }
 
+// Len returns the number of queued components.
+func (m *updateManager) Len() int {
+	var n int
+	for _, updates := range m.pending {
+		n += len(updates)
+	}
+	return n
+}
+
 // ForEach iterates over all queued components, invoking the provided function
 // on each.
 func (m *updateManager) ForEach(do func(Composer)) {
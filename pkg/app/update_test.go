@@ -56,6 +56,21 @@ func TestUpdateManagerDone(t *testing.T) {
 	})
 }
 
+func TestUpdateManagerLen(t *testing.T) {
+	var m updateManager
+	require.Zero(t, m.Len())
+
+	compo := &hello{}
+	m.Add(compo)
+	m.Add(compo)
+	m.Add(&bar{})
+	require.Equal(t, 2, m.Len())
+
+	m.Done(compo)
+	m.Done(compo)
+	require.Equal(t, 1, m.Len())
+}
+
 func TestUpdateManagerForEach(t *testing.T) {
 	var m updateManager
 
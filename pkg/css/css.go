@@ -0,0 +1,369 @@
+//go:generate go run gen/css.go
+//go:generate go fmt
+
+// Package css provides a typed API to write CSS declarations and stylesheets.
+//
+// Declarations are built with Styles and can be passed to the Styles method of
+// go-app HTML elements:
+//
+//	app.Div().Styles(css.Styles().
+//	    Display(css.DisplayFlex).
+//	    JustifyContent(css.JustifyContentCenter).
+//	    Padding(css.Px(12), css.Rem(1)).
+//	    Color(css.RGB(45, 44, 44)),
+//	)
+//
+// Stylesheets are built with Stylesheet, for example to implement the
+// app.Styler interface:
+//
+//	func (b *button) Styles() string {
+//	    return css.Stylesheet(
+//	        css.Select("button", css.Styles().Padding(css.Px(6), css.Px(12))),
+//	        css.Media(css.MaxWidth(css.Px(480)),
+//	            css.Select("button", css.Styles().Width(css.Percent(100))),
+//	        ),
+//	    )
+//	}
+package css
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Style is a set of CSS declarations. It can be passed to the Styles method of
+// go-app HTML elements.
+type Style map[string]string
+
+// Styles creates an empty set of CSS declarations.
+func Styles() Style {
+	return make(Style)
+}
+
+// Set sets the given property with the given value. It is meant for properties
+// that do not have a typed method, such as custom properties.
+func (s Style) Set(property, value string) Style {
+	s[property] = value
+	return s
+}
+
+// Border sets the width, style and color of all the borders.
+func (s Style) Border(width Length, style BorderStyle, color Color) Style {
+	s["border"] = string(width) + " " + string(style) + " " + string(color)
+	return s
+}
+
+// Flex sets how a flex item grows or shrinks to fit the space available in its
+// flex container.
+func (s Style) Flex(grow, shrink float64, basis Length) Style {
+	s["flex"] = formatNumber(grow) + " " + formatNumber(shrink) + " " + string(basis)
+	return s
+}
+
+// FontFamily sets the prioritized list of font family names.
+func (s Style) FontFamily(families ...string) Style {
+	names := make([]string, len(families))
+	for i, f := range families {
+		switch f {
+		case "serif", "sans-serif", "monospace", "cursive", "fantasy", "system-ui":
+			names[i] = f
+
+		default:
+			names[i] = quoteString(f)
+		}
+	}
+	s["font-family"] = strings.Join(names, ", ")
+	return s
+}
+
+// GridTemplateAreas sets the named grid areas, one string per row.
+func (s Style) GridTemplateAreas(rows ...string) Style {
+	areas := make([]string, len(rows))
+	for i, r := range rows {
+		areas[i] = quoteString(r)
+	}
+	s["grid-template-areas"] = strings.Join(areas, " ")
+	return s
+}
+
+// Content sets the text generated by the ::before and ::after
+// pseudo-elements.
+func (s Style) Content(v string) Style {
+	s["content"] = quoteString(v)
+	return s
+}
+
+// String returns the declarations sorted by property, as in a style attribute.
+func (s Style) String() string {
+	var b strings.Builder
+	for i, k := range s.properties() {
+		if i > 0 {
+			b.WriteByte(';')
+		}
+		b.WriteString(k)
+		b.WriteByte(':')
+		b.WriteString(s[k])
+	}
+	return b.String()
+}
+
+func (s Style) properties() []string {
+	properties := make([]string, 0, len(s))
+	for k := range s {
+		properties = append(properties, k)
+	}
+	sort.Strings(properties)
+	return properties
+}
+
+// Length is a CSS length, percentage or grid track size.
+type Length string
+
+const (
+	// Auto lets the browser compute the length.
+	Auto Length = "auto"
+
+	// Zero is a zero length.
+	Zero Length = "0"
+
+	// MinContent is the intrinsic minimum width of the content.
+	MinContent Length = "min-content"
+
+	// MaxContent is the intrinsic preferred width of the content.
+	MaxContent Length = "max-content"
+)
+
+// Calc returns a length computed from the given expression, which is formatted
+// with the given values like fmt.Sprintf.
+func Calc(format string, v ...any) Length {
+	return Length("calc(" + fmt.Sprintf(format, v...) + ")")
+}
+
+// Repeat returns a grid track list that repeats the given tracks the given
+// number of times.
+func Repeat(count int, tracks ...Length) Length {
+	return Length("repeat(" + strconv.Itoa(count) + ", " + joinLengths(tracks) + ")")
+}
+
+// MinMax returns a grid track size between the given minimum and maximum.
+func MinMax(min, max Length) Length {
+	return Length("minmax(" + string(min) + ", " + string(max) + ")")
+}
+
+// Color is a CSS color.
+type Color string
+
+// RGB returns a color from its red, green and blue components.
+func RGB(r, g, b uint8) Color {
+	return Color(fmt.Sprintf("rgb(%d, %d, %d)", r, g, b))
+}
+
+// RGBA returns a color from its red, green and blue components and its
+// opacity, between 0 and 1.
+func RGBA(r, g, b uint8, a float64) Color {
+	return Color(fmt.Sprintf("rgba(%d, %d, %d, %s)", r, g, b, formatNumber(a)))
+}
+
+// HSL returns a color from its hue in degrees, and its saturation and
+// lightness in percent.
+func HSL(h, s, l float64) Color {
+	return Color(fmt.Sprintf("hsl(%s, %s%%, %s%%)", formatNumber(h), formatNumber(s), formatNumber(l)))
+}
+
+// HSLA returns a color from its hue in degrees, its saturation and lightness
+// in percent, and its opacity, between 0 and 1.
+func HSLA(h, s, l, a float64) Color {
+	return Color(fmt.Sprintf("hsla(%s, %s%%, %s%%, %s)", formatNumber(h), formatNumber(s), formatNumber(l), formatNumber(a)))
+}
+
+// Hex returns a color from its hexadecimal notation, with or without the
+// leading #.
+func Hex(v string) Color {
+	return Color("#" + strings.TrimPrefix(v, "#"))
+}
+
+// Var returns a reference to the given custom property.
+//
+// Example:
+//
+//	css.Styles().Color(css.Var[css.Color]("--primary-color"))
+func Var[T ~string](name string) T {
+	return T("var(" + name + ")")
+}
+
+// MediaQuery is a CSS media query.
+type MediaQuery string
+
+const (
+	// Screen matches devices with a screen.
+	Screen MediaQuery = "screen"
+
+	// Print matches printed pages and print previews.
+	Print MediaQuery = "print"
+
+	// PrefersDark matches when the user prefers a dark color scheme.
+	PrefersDark MediaQuery = "(prefers-color-scheme: dark)"
+
+	// PrefersLight matches when the user prefers a light color scheme.
+	PrefersLight MediaQuery = "(prefers-color-scheme: light)"
+
+	// PrefersReducedMotion matches when the user prefers less motion.
+	PrefersReducedMotion MediaQuery = "(prefers-reduced-motion: reduce)"
+
+	// Hover matches when the primary input device can hover over elements.
+	Hover MediaQuery = "(hover: hover)"
+)
+
+// MinWidth matches when the viewport is at least as wide as the given length.
+func MinWidth(v Length) MediaQuery {
+	return MediaQuery("(min-width: " + string(v) + ")")
+}
+
+// MaxWidth matches when the viewport is at most as wide as the given length.
+func MaxWidth(v Length) MediaQuery {
+	return MediaQuery("(max-width: " + string(v) + ")")
+}
+
+// MinHeight matches when the viewport is at least as high as the given length.
+func MinHeight(v Length) MediaQuery {
+	return MediaQuery("(min-height: " + string(v) + ")")
+}
+
+// MaxHeight matches when the viewport is at most as high as the given length.
+func MaxHeight(v Length) MediaQuery {
+	return MediaQuery("(max-height: " + string(v) + ")")
+}
+
+// And matches when all the given queries match. Queries combined with Or are
+// distributed, since "and" binds tighter than the commas of a media query list:
+// And(Or(a, b), c) matches like Or(And(a, c), And(b, c)).
+func And(queries ...MediaQuery) MediaQuery {
+	conjunctions := []string{""}
+	for _, q := range queries {
+		next := make([]string, 0, len(conjunctions))
+		for _, c := range conjunctions {
+			for _, alt := range strings.Split(string(q), ", ") {
+				if c != "" {
+					alt = c + " and " + alt
+				}
+				next = append(next, alt)
+			}
+		}
+		conjunctions = next
+	}
+	return MediaQuery(strings.Join(conjunctions, ", "))
+}
+
+// Or matches when one of the given queries matches.
+func Or(queries ...MediaQuery) MediaQuery {
+	return joinMediaQueries(queries, ", ")
+}
+
+// Rule is a CSS rule.
+type Rule struct {
+	prelude string
+	style   Style
+	rules   []Rule
+}
+
+// Select returns a rule that applies the given declarations to the elements
+// that match the given selector.
+func Select(selector string, s Style) Rule {
+	return Rule{
+		prelude: selector,
+		style:   s,
+	}
+}
+
+// Media returns a rule that applies the given rules when the given media query
+// matches.
+func Media(q MediaQuery, rules ...Rule) Rule {
+	return Rule{
+		prelude: "@media " + string(q),
+		rules:   rules,
+	}
+}
+
+// Stylesheet returns the CSS code of the given rules.
+func Stylesheet(rules ...Rule) string {
+	var b strings.Builder
+	for _, r := range rules {
+		r.write(&b, 0)
+	}
+	return b.String()
+}
+
+func (r Rule) write(b *strings.Builder, indent int) {
+	writeIndent(b, indent)
+	b.WriteString(r.prelude)
+	b.WriteString(" {\n")
+
+	if r.rules != nil {
+		for _, child := range r.rules {
+			child.write(b, indent+1)
+		}
+	} else {
+		for _, k := range r.style.properties() {
+			writeIndent(b, indent+1)
+			b.WriteString(k)
+			b.WriteString(": ")
+			b.WriteString(r.style[k])
+			b.WriteString(";\n")
+		}
+	}
+
+	writeIndent(b, indent)
+	b.WriteString("}\n")
+}
+
+func writeIndent(b *strings.Builder, indent int) {
+	for i := 0; i < indent; i++ {
+		b.WriteString("  ")
+	}
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func joinLengths(v []Length) string {
+	s := make([]string, len(v))
+	for i, l := range v {
+		s[i] = string(l)
+	}
+	return strings.Join(s, " ")
+}
+
+// quoteString returns the given value as a CSS string. Quotes and backslashes
+// are escaped, and control characters are written as hexadecimal escapes.
+func quoteString(v string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range v {
+		switch {
+		case r == '"', r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+
+		case r < 0x20, r == 0x7f:
+			b.WriteByte('\\')
+			b.WriteString(strconv.FormatInt(int64(r), 16))
+			b.WriteByte(' ')
+
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func joinMediaQueries(v []MediaQuery, sep string) MediaQuery {
+	s := make([]string, len(v))
+	for i, q := range v {
+		s[i] = string(q)
+	}
+	return MediaQuery(strings.Join(s, sep))
+}
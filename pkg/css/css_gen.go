@@ -0,0 +1,1197 @@
+package css
+
+// Code generated by go generate; DO NOT EDIT.
+
+import (
+	"strconv"
+)
+
+// Px returns a length in pixels.
+func Px(v float64) Length {
+	return Length(formatNumber(v) + "px")
+}
+
+// Em returns a length in multiples of the font size of the element.
+func Em(v float64) Length {
+	return Length(formatNumber(v) + "em")
+}
+
+// Rem returns a length in multiples of the font size of the root element.
+func Rem(v float64) Length {
+	return Length(formatNumber(v) + "rem")
+}
+
+// Percent returns a length in percents of the parent element size.
+func Percent(v float64) Length {
+	return Length(formatNumber(v) + "%")
+}
+
+// Vw returns a length in percents of the viewport width.
+func Vw(v float64) Length {
+	return Length(formatNumber(v) + "vw")
+}
+
+// Vh returns a length in percents of the viewport height.
+func Vh(v float64) Length {
+	return Length(formatNumber(v) + "vh")
+}
+
+// Vmin returns a length in percents of the smallest viewport dimension.
+func Vmin(v float64) Length {
+	return Length(formatNumber(v) + "vmin")
+}
+
+// Vmax returns a length in percents of the largest viewport dimension.
+func Vmax(v float64) Length {
+	return Length(formatNumber(v) + "vmax")
+}
+
+// Dvh returns a length in percents of the dynamic viewport height.
+func Dvh(v float64) Length {
+	return Length(formatNumber(v) + "dvh")
+}
+
+// Ch returns a length in widths of the "0" character of the element font.
+func Ch(v float64) Length {
+	return Length(formatNumber(v) + "ch")
+}
+
+// Ex returns a length in heights of the "x" character of the element font.
+func Ex(v float64) Length {
+	return Length(formatNumber(v) + "ex")
+}
+
+// Pt returns a length in points.
+func Pt(v float64) Length {
+	return Length(formatNumber(v) + "pt")
+}
+
+// Fr returns a length in fractions of the free space of a grid container.
+func Fr(v float64) Length {
+	return Length(formatNumber(v) + "fr")
+}
+
+// The named colors.
+const (
+	AliceBlue            Color = "aliceblue"
+	AntiqueWhite         Color = "antiquewhite"
+	Aqua                 Color = "aqua"
+	Aquamarine           Color = "aquamarine"
+	Azure                Color = "azure"
+	Beige                Color = "beige"
+	Bisque               Color = "bisque"
+	Black                Color = "black"
+	BlanchedAlmond       Color = "blanchedalmond"
+	Blue                 Color = "blue"
+	BlueViolet           Color = "blueviolet"
+	Brown                Color = "brown"
+	BurlyWood            Color = "burlywood"
+	CadetBlue            Color = "cadetblue"
+	Chartreuse           Color = "chartreuse"
+	Chocolate            Color = "chocolate"
+	Coral                Color = "coral"
+	CornflowerBlue       Color = "cornflowerblue"
+	Cornsilk             Color = "cornsilk"
+	Crimson              Color = "crimson"
+	Cyan                 Color = "cyan"
+	DarkBlue             Color = "darkblue"
+	DarkCyan             Color = "darkcyan"
+	DarkGoldenRod        Color = "darkgoldenrod"
+	DarkGray             Color = "darkgray"
+	DarkGreen            Color = "darkgreen"
+	DarkKhaki            Color = "darkkhaki"
+	DarkMagenta          Color = "darkmagenta"
+	DarkOliveGreen       Color = "darkolivegreen"
+	DarkOrange           Color = "darkorange"
+	DarkOrchid           Color = "darkorchid"
+	DarkRed              Color = "darkred"
+	DarkSalmon           Color = "darksalmon"
+	DarkSeaGreen         Color = "darkseagreen"
+	DarkSlateBlue        Color = "darkslateblue"
+	DarkSlateGray        Color = "darkslategray"
+	DarkTurquoise        Color = "darkturquoise"
+	DarkViolet           Color = "darkviolet"
+	DeepPink             Color = "deeppink"
+	DeepSkyBlue          Color = "deepskyblue"
+	DimGray              Color = "dimgray"
+	DodgerBlue           Color = "dodgerblue"
+	FireBrick            Color = "firebrick"
+	FloralWhite          Color = "floralwhite"
+	ForestGreen          Color = "forestgreen"
+	Fuchsia              Color = "fuchsia"
+	Gainsboro            Color = "gainsboro"
+	GhostWhite           Color = "ghostwhite"
+	Gold                 Color = "gold"
+	GoldenRod            Color = "goldenrod"
+	Gray                 Color = "gray"
+	Green                Color = "green"
+	GreenYellow          Color = "greenyellow"
+	HoneyDew             Color = "honeydew"
+	HotPink              Color = "hotpink"
+	IndianRed            Color = "indianred"
+	Indigo               Color = "indigo"
+	Ivory                Color = "ivory"
+	Khaki                Color = "khaki"
+	Lavender             Color = "lavender"
+	LavenderBlush        Color = "lavenderblush"
+	LawnGreen            Color = "lawngreen"
+	LemonChiffon         Color = "lemonchiffon"
+	LightBlue            Color = "lightblue"
+	LightCoral           Color = "lightcoral"
+	LightCyan            Color = "lightcyan"
+	LightGoldenRodYellow Color = "lightgoldenrodyellow"
+	LightGray            Color = "lightgray"
+	LightGreen           Color = "lightgreen"
+	LightPink            Color = "lightpink"
+	LightSalmon          Color = "lightsalmon"
+	LightSeaGreen        Color = "lightseagreen"
+	LightSkyBlue         Color = "lightskyblue"
+	LightSlateGray       Color = "lightslategray"
+	LightSteelBlue       Color = "lightsteelblue"
+	LightYellow          Color = "lightyellow"
+	Lime                 Color = "lime"
+	LimeGreen            Color = "limegreen"
+	Linen                Color = "linen"
+	Magenta              Color = "magenta"
+	Maroon               Color = "maroon"
+	MediumAquaMarine     Color = "mediumaquamarine"
+	MediumBlue           Color = "mediumblue"
+	MediumOrchid         Color = "mediumorchid"
+	MediumPurple         Color = "mediumpurple"
+	MediumSeaGreen       Color = "mediumseagreen"
+	MediumSlateBlue      Color = "mediumslateblue"
+	MediumSpringGreen    Color = "mediumspringgreen"
+	MediumTurquoise      Color = "mediumturquoise"
+	MediumVioletRed      Color = "mediumvioletred"
+	MidnightBlue         Color = "midnightblue"
+	MintCream            Color = "mintcream"
+	MistyRose            Color = "mistyrose"
+	Moccasin             Color = "moccasin"
+	NavajoWhite          Color = "navajowhite"
+	Navy                 Color = "navy"
+	OldLace              Color = "oldlace"
+	Olive                Color = "olive"
+	OliveDrab            Color = "olivedrab"
+	Orange               Color = "orange"
+	OrangeRed            Color = "orangered"
+	Orchid               Color = "orchid"
+	PaleGoldenRod        Color = "palegoldenrod"
+	PaleGreen            Color = "palegreen"
+	PaleTurquoise        Color = "paleturquoise"
+	PaleVioletRed        Color = "palevioletred"
+	PapayaWhip           Color = "papayawhip"
+	PeachPuff            Color = "peachpuff"
+	Peru                 Color = "peru"
+	Pink                 Color = "pink"
+	Plum                 Color = "plum"
+	PowderBlue           Color = "powderblue"
+	Purple               Color = "purple"
+	RebeccaPurple        Color = "rebeccapurple"
+	Red                  Color = "red"
+	RosyBrown            Color = "rosybrown"
+	RoyalBlue            Color = "royalblue"
+	SaddleBrown          Color = "saddlebrown"
+	Salmon               Color = "salmon"
+	SandyBrown           Color = "sandybrown"
+	SeaGreen             Color = "seagreen"
+	SeaShell             Color = "seashell"
+	Sienna               Color = "sienna"
+	Silver               Color = "silver"
+	SkyBlue              Color = "skyblue"
+	SlateBlue            Color = "slateblue"
+	SlateGray            Color = "slategray"
+	Snow                 Color = "snow"
+	SpringGreen          Color = "springgreen"
+	SteelBlue            Color = "steelblue"
+	Tan                  Color = "tan"
+	Teal                 Color = "teal"
+	Thistle              Color = "thistle"
+	Tomato               Color = "tomato"
+	Transparent          Color = "transparent"
+	Turquoise            Color = "turquoise"
+	Violet               Color = "violet"
+	Wheat                Color = "wheat"
+	White                Color = "white"
+	WhiteSmoke           Color = "whitesmoke"
+	Yellow               Color = "yellow"
+	YellowGreen          Color = "yellowgreen"
+	CurrentColor         Color = "currentcolor"
+)
+
+// AlignContent represents how the space between and around the lines of a flex or grid container is distributed along its cross axis.
+type AlignContent string
+
+const (
+	AlignContentNormal       AlignContent = "normal"
+	AlignContentStart        AlignContent = "start"
+	AlignContentEnd          AlignContent = "end"
+	AlignContentCenter       AlignContent = "center"
+	AlignContentFlexStart    AlignContent = "flex-start"
+	AlignContentFlexEnd      AlignContent = "flex-end"
+	AlignContentSpaceBetween AlignContent = "space-between"
+	AlignContentSpaceAround  AlignContent = "space-around"
+	AlignContentSpaceEvenly  AlignContent = "space-evenly"
+	AlignContentStretch      AlignContent = "stretch"
+)
+
+// AlignItems represents how the items of a flex or grid container are aligned along its cross axis.
+type AlignItems string
+
+const (
+	AlignItemsNormal    AlignItems = "normal"
+	AlignItemsStretch   AlignItems = "stretch"
+	AlignItemsCenter    AlignItems = "center"
+	AlignItemsStart     AlignItems = "start"
+	AlignItemsEnd       AlignItems = "end"
+	AlignItemsFlexStart AlignItems = "flex-start"
+	AlignItemsFlexEnd   AlignItems = "flex-end"
+	AlignItemsBaseline  AlignItems = "baseline"
+)
+
+// AlignSelf represents how an item is aligned along the cross axis of its flex or grid container.
+type AlignSelf string
+
+const (
+	AlignSelfAuto      AlignSelf = "auto"
+	AlignSelfNormal    AlignSelf = "normal"
+	AlignSelfStretch   AlignSelf = "stretch"
+	AlignSelfCenter    AlignSelf = "center"
+	AlignSelfStart     AlignSelf = "start"
+	AlignSelfEnd       AlignSelf = "end"
+	AlignSelfFlexStart AlignSelf = "flex-start"
+	AlignSelfFlexEnd   AlignSelf = "flex-end"
+	AlignSelfBaseline  AlignSelf = "baseline"
+)
+
+// BorderStyle represents the line style of a border.
+type BorderStyle string
+
+const (
+	BorderStyleNone   BorderStyle = "none"
+	BorderStyleHidden BorderStyle = "hidden"
+	BorderStyleDotted BorderStyle = "dotted"
+	BorderStyleDashed BorderStyle = "dashed"
+	BorderStyleSolid  BorderStyle = "solid"
+	BorderStyleDouble BorderStyle = "double"
+	BorderStyleGroove BorderStyle = "groove"
+	BorderStyleRidge  BorderStyle = "ridge"
+	BorderStyleInset  BorderStyle = "inset"
+	BorderStyleOutset BorderStyle = "outset"
+)
+
+// BoxSizing represents how the width and height of an element are calculated.
+type BoxSizing string
+
+const (
+	BoxSizingContentBox BoxSizing = "content-box"
+	BoxSizingBorderBox  BoxSizing = "border-box"
+)
+
+// Clear represents whether an element is moved below the floating elements that precede it.
+type Clear string
+
+const (
+	ClearNone  Clear = "none"
+	ClearLeft  Clear = "left"
+	ClearRight Clear = "right"
+	ClearBoth  Clear = "both"
+)
+
+// Cursor represents the mouse cursor displayed when the pointer is over an element.
+type Cursor string
+
+const (
+	CursorAuto       Cursor = "auto"
+	CursorDefault    Cursor = "default"
+	CursorNone       Cursor = "none"
+	CursorPointer    Cursor = "pointer"
+	CursorText       Cursor = "text"
+	CursorMove       Cursor = "move"
+	CursorNotAllowed Cursor = "not-allowed"
+	CursorGrab       Cursor = "grab"
+	CursorGrabbing   Cursor = "grabbing"
+	CursorWait       Cursor = "wait"
+	CursorProgress   Cursor = "progress"
+	CursorHelp       Cursor = "help"
+	CursorCrosshair  Cursor = "crosshair"
+	CursorZoomIn     Cursor = "zoom-in"
+	CursorZoomOut    Cursor = "zoom-out"
+	CursorColResize  Cursor = "col-resize"
+	CursorRowResize  Cursor = "row-resize"
+)
+
+// Display represents how an element and its children are laid out.
+type Display string
+
+const (
+	DisplayNone        Display = "none"
+	DisplayBlock       Display = "block"
+	DisplayInline      Display = "inline"
+	DisplayInlineBlock Display = "inline-block"
+	DisplayFlex        Display = "flex"
+	DisplayInlineFlex  Display = "inline-flex"
+	DisplayGrid        Display = "grid"
+	DisplayInlineGrid  Display = "inline-grid"
+	DisplayContents    Display = "contents"
+	DisplayTable       Display = "table"
+	DisplayTableRow    Display = "table-row"
+	DisplayTableCell   Display = "table-cell"
+	DisplayListItem    Display = "list-item"
+)
+
+// FlexDirection represents the direction of the main axis of a flex container.
+type FlexDirection string
+
+const (
+	FlexDirectionRow           FlexDirection = "row"
+	FlexDirectionRowReverse    FlexDirection = "row-reverse"
+	FlexDirectionColumn        FlexDirection = "column"
+	FlexDirectionColumnReverse FlexDirection = "column-reverse"
+)
+
+// FlexWrap represents whether the items of a flex container are wrapped onto multiple lines.
+type FlexWrap string
+
+const (
+	FlexWrapNowrap      FlexWrap = "nowrap"
+	FlexWrapWrap        FlexWrap = "wrap"
+	FlexWrapWrapReverse FlexWrap = "wrap-reverse"
+)
+
+// Float represents the side of its container where an element is placed.
+type Float string
+
+const (
+	FloatNone        Float = "none"
+	FloatLeft        Float = "left"
+	FloatRight       Float = "right"
+	FloatInlineStart Float = "inline-start"
+	FloatInlineEnd   Float = "inline-end"
+)
+
+// FontStyle represents whether a font is styled with a normal, italic or oblique face.
+type FontStyle string
+
+const (
+	FontStyleNormal  FontStyle = "normal"
+	FontStyleItalic  FontStyle = "italic"
+	FontStyleOblique FontStyle = "oblique"
+)
+
+// FontWeight represents the weight of a font.
+type FontWeight string
+
+const (
+	FontWeightNormal  FontWeight = "normal"
+	FontWeightBold    FontWeight = "bold"
+	FontWeightBolder  FontWeight = "bolder"
+	FontWeightLighter FontWeight = "lighter"
+	FontWeight100     FontWeight = "100"
+	FontWeight200     FontWeight = "200"
+	FontWeight300     FontWeight = "300"
+	FontWeight400     FontWeight = "400"
+	FontWeight500     FontWeight = "500"
+	FontWeight600     FontWeight = "600"
+	FontWeight700     FontWeight = "700"
+	FontWeight800     FontWeight = "800"
+	FontWeight900     FontWeight = "900"
+)
+
+// GridAutoFlow represents how the items that are not explicitly placed are flowed into a grid container.
+type GridAutoFlow string
+
+const (
+	GridAutoFlowRow         GridAutoFlow = "row"
+	GridAutoFlowColumn      GridAutoFlow = "column"
+	GridAutoFlowDense       GridAutoFlow = "dense"
+	GridAutoFlowRowDense    GridAutoFlow = "row dense"
+	GridAutoFlowColumnDense GridAutoFlow = "column dense"
+)
+
+// JustifyContent represents how the space between and around the items of a flex or grid container is distributed along its main axis.
+type JustifyContent string
+
+const (
+	JustifyContentNormal       JustifyContent = "normal"
+	JustifyContentStart        JustifyContent = "start"
+	JustifyContentEnd          JustifyContent = "end"
+	JustifyContentCenter       JustifyContent = "center"
+	JustifyContentFlexStart    JustifyContent = "flex-start"
+	JustifyContentFlexEnd      JustifyContent = "flex-end"
+	JustifyContentLeft         JustifyContent = "left"
+	JustifyContentRight        JustifyContent = "right"
+	JustifyContentSpaceBetween JustifyContent = "space-between"
+	JustifyContentSpaceAround  JustifyContent = "space-around"
+	JustifyContentSpaceEvenly  JustifyContent = "space-evenly"
+	JustifyContentStretch      JustifyContent = "stretch"
+)
+
+// JustifyItems represents how the items of a grid container are aligned along its inline axis.
+type JustifyItems string
+
+const (
+	JustifyItemsNormal   JustifyItems = "normal"
+	JustifyItemsStretch  JustifyItems = "stretch"
+	JustifyItemsCenter   JustifyItems = "center"
+	JustifyItemsStart    JustifyItems = "start"
+	JustifyItemsEnd      JustifyItems = "end"
+	JustifyItemsLeft     JustifyItems = "left"
+	JustifyItemsRight    JustifyItems = "right"
+	JustifyItemsBaseline JustifyItems = "baseline"
+)
+
+// JustifySelf represents how an item is aligned along the inline axis of its grid container.
+type JustifySelf string
+
+const (
+	JustifySelfAuto     JustifySelf = "auto"
+	JustifySelfNormal   JustifySelf = "normal"
+	JustifySelfStretch  JustifySelf = "stretch"
+	JustifySelfCenter   JustifySelf = "center"
+	JustifySelfStart    JustifySelf = "start"
+	JustifySelfEnd      JustifySelf = "end"
+	JustifySelfLeft     JustifySelf = "left"
+	JustifySelfRight    JustifySelf = "right"
+	JustifySelfBaseline JustifySelf = "baseline"
+)
+
+// ListStyleType represents the marker of a list item.
+type ListStyleType string
+
+const (
+	ListStyleTypeNone    ListStyleType = "none"
+	ListStyleTypeDisc    ListStyleType = "disc"
+	ListStyleTypeCircle  ListStyleType = "circle"
+	ListStyleTypeSquare  ListStyleType = "square"
+	ListStyleTypeDecimal ListStyleType = "decimal"
+)
+
+// ObjectFit represents how the content of a replaced element, such as an image, is resized to fit its box.
+type ObjectFit string
+
+const (
+	ObjectFitFill      ObjectFit = "fill"
+	ObjectFitContain   ObjectFit = "contain"
+	ObjectFitCover     ObjectFit = "cover"
+	ObjectFitNone      ObjectFit = "none"
+	ObjectFitScaleDown ObjectFit = "scale-down"
+)
+
+// Overflow represents what happens when the content of an element is too big to fit in its box.
+type Overflow string
+
+const (
+	OverflowVisible Overflow = "visible"
+	OverflowHidden  Overflow = "hidden"
+	OverflowClip    Overflow = "clip"
+	OverflowScroll  Overflow = "scroll"
+	OverflowAuto    Overflow = "auto"
+)
+
+// PointerEvents represents whether an element can be the target of pointer events.
+type PointerEvents string
+
+const (
+	PointerEventsAuto PointerEvents = "auto"
+	PointerEventsNone PointerEvents = "none"
+)
+
+// Position represents how an element is positioned in the document.
+type Position string
+
+const (
+	PositionStatic   Position = "static"
+	PositionRelative Position = "relative"
+	PositionAbsolute Position = "absolute"
+	PositionFixed    Position = "fixed"
+	PositionSticky   Position = "sticky"
+)
+
+// Resize represents whether and in which directions an element is resizable by the user.
+type Resize string
+
+const (
+	ResizeNone       Resize = "none"
+	ResizeBoth       Resize = "both"
+	ResizeHorizontal Resize = "horizontal"
+	ResizeVertical   Resize = "vertical"
+)
+
+// ScrollBehavior represents the scrolling behavior of a scroll container.
+type ScrollBehavior string
+
+const (
+	ScrollBehaviorAuto   ScrollBehavior = "auto"
+	ScrollBehaviorSmooth ScrollBehavior = "smooth"
+)
+
+// TextAlign represents the horizontal alignment of the inline content of a block.
+type TextAlign string
+
+const (
+	TextAlignStart   TextAlign = "start"
+	TextAlignEnd     TextAlign = "end"
+	TextAlignLeft    TextAlign = "left"
+	TextAlignRight   TextAlign = "right"
+	TextAlignCenter  TextAlign = "center"
+	TextAlignJustify TextAlign = "justify"
+)
+
+// TextDecorationLine represents the kind of decoration of a text.
+type TextDecorationLine string
+
+const (
+	TextDecorationLineNone        TextDecorationLine = "none"
+	TextDecorationLineUnderline   TextDecorationLine = "underline"
+	TextDecorationLineOverline    TextDecorationLine = "overline"
+	TextDecorationLineLineThrough TextDecorationLine = "line-through"
+)
+
+// TextOverflow represents how the overflowing content of a text is signaled to the user.
+type TextOverflow string
+
+const (
+	TextOverflowClip     TextOverflow = "clip"
+	TextOverflowEllipsis TextOverflow = "ellipsis"
+)
+
+// TextTransform represents the capitalization of a text.
+type TextTransform string
+
+const (
+	TextTransformNone       TextTransform = "none"
+	TextTransformCapitalize TextTransform = "capitalize"
+	TextTransformUppercase  TextTransform = "uppercase"
+	TextTransformLowercase  TextTransform = "lowercase"
+)
+
+// UserSelect represents whether the text of an element can be selected by the user.
+type UserSelect string
+
+const (
+	UserSelectAuto UserSelect = "auto"
+	UserSelectNone UserSelect = "none"
+	UserSelectText UserSelect = "text"
+	UserSelectAll  UserSelect = "all"
+)
+
+// VerticalAlign represents the vertical alignment of an inline or table cell element.
+type VerticalAlign string
+
+const (
+	VerticalAlignBaseline   VerticalAlign = "baseline"
+	VerticalAlignSub        VerticalAlign = "sub"
+	VerticalAlignSuper      VerticalAlign = "super"
+	VerticalAlignTextTop    VerticalAlign = "text-top"
+	VerticalAlignTextBottom VerticalAlign = "text-bottom"
+	VerticalAlignMiddle     VerticalAlign = "middle"
+	VerticalAlignTop        VerticalAlign = "top"
+	VerticalAlignBottom     VerticalAlign = "bottom"
+)
+
+// Visibility represents whether an element is visible, without changing the layout.
+type Visibility string
+
+const (
+	VisibilityVisible  Visibility = "visible"
+	VisibilityHidden   Visibility = "hidden"
+	VisibilityCollapse Visibility = "collapse"
+)
+
+// WhiteSpace represents how the white spaces of a text are handled.
+type WhiteSpace string
+
+const (
+	WhiteSpaceNormal      WhiteSpace = "normal"
+	WhiteSpaceNowrap      WhiteSpace = "nowrap"
+	WhiteSpacePre         WhiteSpace = "pre"
+	WhiteSpacePreWrap     WhiteSpace = "pre-wrap"
+	WhiteSpacePreLine     WhiteSpace = "pre-line"
+	WhiteSpaceBreakSpaces WhiteSpace = "break-spaces"
+)
+
+// WordBreak represents where line breaks are inserted in a text that would overflow its box.
+type WordBreak string
+
+const (
+	WordBreakNormal    WordBreak = "normal"
+	WordBreakBreakAll  WordBreak = "break-all"
+	WordBreakKeepAll   WordBreak = "keep-all"
+	WordBreakBreakWord WordBreak = "break-word"
+)
+
+// AccentColor sets the color of the user interface controls, such as checkboxes.
+func (s Style) AccentColor(v Color) Style {
+	s["accent-color"] = string(v)
+	return s
+}
+
+// AlignContent sets how the space between and around the lines of a container is distributed along its cross axis.
+func (s Style) AlignContent(v AlignContent) Style {
+	s["align-content"] = string(v)
+	return s
+}
+
+// AlignItems sets how the items of a container are aligned along its cross axis.
+func (s Style) AlignItems(v AlignItems) Style {
+	s["align-items"] = string(v)
+	return s
+}
+
+// AlignSelf sets how an item is aligned along the cross axis of its container.
+func (s Style) AlignSelf(v AlignSelf) Style {
+	s["align-self"] = string(v)
+	return s
+}
+
+// Animation sets the animations of an element, such as "spin 1s linear infinite".
+func (s Style) Animation(v string) Style {
+	s["animation"] = v
+	return s
+}
+
+// Background sets all the background properties of an element.
+func (s Style) Background(v string) Style {
+	s["background"] = v
+	return s
+}
+
+// BackgroundColor sets the background color of an element.
+func (s Style) BackgroundColor(v Color) Style {
+	s["background-color"] = string(v)
+	return s
+}
+
+// BackgroundImage sets the background images of an element, such as "url(/web/bg.png)".
+func (s Style) BackgroundImage(v string) Style {
+	s["background-image"] = v
+	return s
+}
+
+// BorderColor sets the color of the borders of an element.
+func (s Style) BorderColor(v Color) Style {
+	s["border-color"] = string(v)
+	return s
+}
+
+// BorderRadius sets the radius of the corners of an element, in the top-left, top-right, bottom-right and bottom-left order.
+func (s Style) BorderRadius(v ...Length) Style {
+	s["border-radius"] = joinLengths(v)
+	return s
+}
+
+// BorderStyle sets the line style of the borders of an element.
+func (s Style) BorderStyle(v BorderStyle) Style {
+	s["border-style"] = string(v)
+	return s
+}
+
+// BorderWidth sets the widths of the borders of an element, in the top, right, bottom and left order.
+func (s Style) BorderWidth(v ...Length) Style {
+	s["border-width"] = joinLengths(v)
+	return s
+}
+
+// Bottom sets the bottom offset of a positioned element.
+func (s Style) Bottom(v Length) Style {
+	s["bottom"] = string(v)
+	return s
+}
+
+// BoxShadow sets the shadows of an element, such as "0 1px 2px rgba(0, 0, 0, 0.2)".
+func (s Style) BoxShadow(v string) Style {
+	s["box-shadow"] = v
+	return s
+}
+
+// BoxSizing sets how the width and height of an element are calculated.
+func (s Style) BoxSizing(v BoxSizing) Style {
+	s["box-sizing"] = string(v)
+	return s
+}
+
+// CaretColor sets the color of the insertion caret of an editable element.
+func (s Style) CaretColor(v Color) Style {
+	s["caret-color"] = string(v)
+	return s
+}
+
+// Clear sets whether an element is moved below the floating elements that precede it.
+func (s Style) Clear(v Clear) Style {
+	s["clear"] = string(v)
+	return s
+}
+
+// Color sets the color of the text of an element.
+func (s Style) Color(v Color) Style {
+	s["color"] = string(v)
+	return s
+}
+
+// ColumnGap sets the gap between the columns of a flex or grid container.
+func (s Style) ColumnGap(v Length) Style {
+	s["column-gap"] = string(v)
+	return s
+}
+
+// Cursor sets the mouse cursor displayed when the pointer is over an element.
+func (s Style) Cursor(v Cursor) Style {
+	s["cursor"] = string(v)
+	return s
+}
+
+// Display sets how an element and its children are laid out.
+func (s Style) Display(v Display) Style {
+	s["display"] = string(v)
+	return s
+}
+
+// Fill sets the color used to paint the interior of an SVG shape.
+func (s Style) Fill(v Color) Style {
+	s["fill"] = string(v)
+	return s
+}
+
+// Filter sets the graphical effects of an element, such as "blur(2px)".
+func (s Style) Filter(v string) Style {
+	s["filter"] = v
+	return s
+}
+
+// FlexBasis sets the initial main size of a flex item.
+func (s Style) FlexBasis(v Length) Style {
+	s["flex-basis"] = string(v)
+	return s
+}
+
+// FlexDirection sets the direction of the main axis of a flex container.
+func (s Style) FlexDirection(v FlexDirection) Style {
+	s["flex-direction"] = string(v)
+	return s
+}
+
+// FlexGrow sets how much a flex item grows relative to the other items.
+func (s Style) FlexGrow(v float64) Style {
+	s["flex-grow"] = formatNumber(v)
+	return s
+}
+
+// FlexShrink sets how much a flex item shrinks relative to the other items.
+func (s Style) FlexShrink(v float64) Style {
+	s["flex-shrink"] = formatNumber(v)
+	return s
+}
+
+// FlexWrap sets whether the items of a flex container are wrapped onto multiple lines.
+func (s Style) FlexWrap(v FlexWrap) Style {
+	s["flex-wrap"] = string(v)
+	return s
+}
+
+// Float sets the side of its container where an element is placed.
+func (s Style) Float(v Float) Style {
+	s["float"] = string(v)
+	return s
+}
+
+// FontSize sets the size of a font.
+func (s Style) FontSize(v Length) Style {
+	s["font-size"] = string(v)
+	return s
+}
+
+// FontStyle sets whether a font is styled with a normal, italic or oblique face.
+func (s Style) FontStyle(v FontStyle) Style {
+	s["font-style"] = string(v)
+	return s
+}
+
+// FontWeight sets the weight of a font.
+func (s Style) FontWeight(v FontWeight) Style {
+	s["font-weight"] = string(v)
+	return s
+}
+
+// Gap sets the gaps between the rows and the columns of a flex or grid container.
+func (s Style) Gap(v ...Length) Style {
+	s["gap"] = joinLengths(v)
+	return s
+}
+
+// GridArea sets the placement of an item in a grid container, such as a named area.
+func (s Style) GridArea(v string) Style {
+	s["grid-area"] = v
+	return s
+}
+
+// GridAutoColumns sets the sizes of the implicitly created columns of a grid container.
+func (s Style) GridAutoColumns(v ...Length) Style {
+	s["grid-auto-columns"] = joinLengths(v)
+	return s
+}
+
+// GridAutoFlow sets how the items that are not explicitly placed are flowed into a grid container.
+func (s Style) GridAutoFlow(v GridAutoFlow) Style {
+	s["grid-auto-flow"] = string(v)
+	return s
+}
+
+// GridAutoRows sets the sizes of the implicitly created rows of a grid container.
+func (s Style) GridAutoRows(v ...Length) Style {
+	s["grid-auto-rows"] = joinLengths(v)
+	return s
+}
+
+// GridColumn sets the placement of an item in the columns of a grid container, such as "1 / 3".
+func (s Style) GridColumn(v string) Style {
+	s["grid-column"] = v
+	return s
+}
+
+// GridRow sets the placement of an item in the rows of a grid container, such as "1 / span 2".
+func (s Style) GridRow(v string) Style {
+	s["grid-row"] = v
+	return s
+}
+
+// GridTemplateColumns sets the sizes of the columns of a grid container.
+func (s Style) GridTemplateColumns(v ...Length) Style {
+	s["grid-template-columns"] = joinLengths(v)
+	return s
+}
+
+// GridTemplateRows sets the sizes of the rows of a grid container.
+func (s Style) GridTemplateRows(v ...Length) Style {
+	s["grid-template-rows"] = joinLengths(v)
+	return s
+}
+
+// Height sets the height of an element.
+func (s Style) Height(v Length) Style {
+	s["height"] = string(v)
+	return s
+}
+
+// Inset sets the top, right, bottom and left offsets of a positioned element.
+func (s Style) Inset(v ...Length) Style {
+	s["inset"] = joinLengths(v)
+	return s
+}
+
+// JustifyContent sets how the space between and around the items of a container is distributed along its main axis.
+func (s Style) JustifyContent(v JustifyContent) Style {
+	s["justify-content"] = string(v)
+	return s
+}
+
+// JustifyItems sets how the items of a grid container are aligned along its inline axis.
+func (s Style) JustifyItems(v JustifyItems) Style {
+	s["justify-items"] = string(v)
+	return s
+}
+
+// JustifySelf sets how an item is aligned along the inline axis of its grid container.
+func (s Style) JustifySelf(v JustifySelf) Style {
+	s["justify-self"] = string(v)
+	return s
+}
+
+// Left sets the left offset of a positioned element.
+func (s Style) Left(v Length) Style {
+	s["left"] = string(v)
+	return s
+}
+
+// LetterSpacing sets the space between the characters of a text.
+func (s Style) LetterSpacing(v Length) Style {
+	s["letter-spacing"] = string(v)
+	return s
+}
+
+// LineHeight sets the height of a line box, as a multiple of the font size.
+func (s Style) LineHeight(v float64) Style {
+	s["line-height"] = formatNumber(v)
+	return s
+}
+
+// ListStyleType sets the marker of a list item.
+func (s Style) ListStyleType(v ListStyleType) Style {
+	s["list-style-type"] = string(v)
+	return s
+}
+
+// Margin sets the margins of an element, in the top, right, bottom and left order.
+func (s Style) Margin(v ...Length) Style {
+	s["margin"] = joinLengths(v)
+	return s
+}
+
+// MarginBottom sets the bottom margin of an element.
+func (s Style) MarginBottom(v Length) Style {
+	s["margin-bottom"] = string(v)
+	return s
+}
+
+// MarginLeft sets the left margin of an element.
+func (s Style) MarginLeft(v Length) Style {
+	s["margin-left"] = string(v)
+	return s
+}
+
+// MarginRight sets the right margin of an element.
+func (s Style) MarginRight(v Length) Style {
+	s["margin-right"] = string(v)
+	return s
+}
+
+// MarginTop sets the top margin of an element.
+func (s Style) MarginTop(v Length) Style {
+	s["margin-top"] = string(v)
+	return s
+}
+
+// MaxHeight sets the maximum height of an element.
+func (s Style) MaxHeight(v Length) Style {
+	s["max-height"] = string(v)
+	return s
+}
+
+// MaxWidth sets the maximum width of an element.
+func (s Style) MaxWidth(v Length) Style {
+	s["max-width"] = string(v)
+	return s
+}
+
+// MinHeight sets the minimum height of an element.
+func (s Style) MinHeight(v Length) Style {
+	s["min-height"] = string(v)
+	return s
+}
+
+// MinWidth sets the minimum width of an element.
+func (s Style) MinWidth(v Length) Style {
+	s["min-width"] = string(v)
+	return s
+}
+
+// ObjectFit sets how the content of a replaced element is resized to fit its box.
+func (s Style) ObjectFit(v ObjectFit) Style {
+	s["object-fit"] = string(v)
+	return s
+}
+
+// Opacity sets the opacity of an element, between 0 and 1.
+func (s Style) Opacity(v float64) Style {
+	s["opacity"] = formatNumber(v)
+	return s
+}
+
+// Order sets the order of an item in its flex or grid container.
+func (s Style) Order(v int) Style {
+	s["order"] = strconv.Itoa(v)
+	return s
+}
+
+// OutlineColor sets the color of the outline of an element.
+func (s Style) OutlineColor(v Color) Style {
+	s["outline-color"] = string(v)
+	return s
+}
+
+// OutlineOffset sets the space between the outline and the border of an element.
+func (s Style) OutlineOffset(v Length) Style {
+	s["outline-offset"] = string(v)
+	return s
+}
+
+// OutlineStyle sets the line style of the outline of an element.
+func (s Style) OutlineStyle(v BorderStyle) Style {
+	s["outline-style"] = string(v)
+	return s
+}
+
+// OutlineWidth sets the width of the outline of an element.
+func (s Style) OutlineWidth(v Length) Style {
+	s["outline-width"] = string(v)
+	return s
+}
+
+// Overflow sets what happens when the content of an element is too big to fit in its box.
+func (s Style) Overflow(v Overflow) Style {
+	s["overflow"] = string(v)
+	return s
+}
+
+// OverflowX sets what happens when the content of an element is too wide to fit in its box.
+func (s Style) OverflowX(v Overflow) Style {
+	s["overflow-x"] = string(v)
+	return s
+}
+
+// OverflowY sets what happens when the content of an element is too high to fit in its box.
+func (s Style) OverflowY(v Overflow) Style {
+	s["overflow-y"] = string(v)
+	return s
+}
+
+// Padding sets the paddings of an element, in the top, right, bottom and left order.
+func (s Style) Padding(v ...Length) Style {
+	s["padding"] = joinLengths(v)
+	return s
+}
+
+// PaddingBottom sets the bottom padding of an element.
+func (s Style) PaddingBottom(v Length) Style {
+	s["padding-bottom"] = string(v)
+	return s
+}
+
+// PaddingLeft sets the left padding of an element.
+func (s Style) PaddingLeft(v Length) Style {
+	s["padding-left"] = string(v)
+	return s
+}
+
+// PaddingRight sets the right padding of an element.
+func (s Style) PaddingRight(v Length) Style {
+	s["padding-right"] = string(v)
+	return s
+}
+
+// PaddingTop sets the top padding of an element.
+func (s Style) PaddingTop(v Length) Style {
+	s["padding-top"] = string(v)
+	return s
+}
+
+// PointerEvents sets whether an element can be the target of pointer events.
+func (s Style) PointerEvents(v PointerEvents) Style {
+	s["pointer-events"] = string(v)
+	return s
+}
+
+// Position sets how an element is positioned in the document.
+func (s Style) Position(v Position) Style {
+	s["position"] = string(v)
+	return s
+}
+
+// Resize sets whether and in which directions an element is resizable by the user.
+func (s Style) Resize(v Resize) Style {
+	s["resize"] = string(v)
+	return s
+}
+
+// Right sets the right offset of a positioned element.
+func (s Style) Right(v Length) Style {
+	s["right"] = string(v)
+	return s
+}
+
+// RowGap sets the gap between the rows of a flex or grid container.
+func (s Style) RowGap(v Length) Style {
+	s["row-gap"] = string(v)
+	return s
+}
+
+// ScrollBehavior sets the scrolling behavior of a scroll container.
+func (s Style) ScrollBehavior(v ScrollBehavior) Style {
+	s["scroll-behavior"] = string(v)
+	return s
+}
+
+// Stroke sets the color used to paint the outline of an SVG shape.
+func (s Style) Stroke(v Color) Style {
+	s["stroke"] = string(v)
+	return s
+}
+
+// TextAlign sets the horizontal alignment of the inline content of a block.
+func (s Style) TextAlign(v TextAlign) Style {
+	s["text-align"] = string(v)
+	return s
+}
+
+// TextDecorationLine sets the kind of decoration of a text.
+func (s Style) TextDecorationLine(v TextDecorationLine) Style {
+	s["text-decoration-line"] = string(v)
+	return s
+}
+
+// TextIndent sets the indentation of the first line of a text.
+func (s Style) TextIndent(v Length) Style {
+	s["text-indent"] = string(v)
+	return s
+}
+
+// TextOverflow sets how the overflowing content of a text is signaled to the user.
+func (s Style) TextOverflow(v TextOverflow) Style {
+	s["text-overflow"] = string(v)
+	return s
+}
+
+// TextTransform sets the capitalization of a text.
+func (s Style) TextTransform(v TextTransform) Style {
+	s["text-transform"] = string(v)
+	return s
+}
+
+// Top sets the top offset of a positioned element.
+func (s Style) Top(v Length) Style {
+	s["top"] = string(v)
+	return s
+}
+
+// Transform sets the transformations of an element, such as "rotate(45deg)".
+func (s Style) Transform(v string) Style {
+	s["transform"] = v
+	return s
+}
+
+// Transition sets the transitions of an element, such as "opacity 0.3s ease".
+func (s Style) Transition(v string) Style {
+	s["transition"] = v
+	return s
+}
+
+// UserSelect sets whether the text of an element can be selected by the user.
+func (s Style) UserSelect(v UserSelect) Style {
+	s["user-select"] = string(v)
+	return s
+}
+
+// VerticalAlign sets the vertical alignment of an inline or table cell element.
+func (s Style) VerticalAlign(v VerticalAlign) Style {
+	s["vertical-align"] = string(v)
+	return s
+}
+
+// Visibility sets whether an element is visible, without changing the layout.
+func (s Style) Visibility(v Visibility) Style {
+	s["visibility"] = string(v)
+	return s
+}
+
+// WhiteSpace sets how the white spaces of a text are handled.
+func (s Style) WhiteSpace(v WhiteSpace) Style {
+	s["white-space"] = string(v)
+	return s
+}
+
+// Width sets the width of an element.
+func (s Style) Width(v Length) Style {
+	s["width"] = string(v)
+	return s
+}
+
+// WordBreak sets where line breaks are inserted in a text that would overflow its box.
+func (s Style) WordBreak(v WordBreak) Style {
+	s["word-break"] = string(v)
+	return s
+}
+
+// ZIndex sets the stack order of a positioned element.
+func (s Style) ZIndex(v int) Style {
+	s["z-index"] = strconv.Itoa(v)
+	return s
+}
@@ -0,0 +1,124 @@
+package css
+
+// Code generated by go generate; DO NOT EDIT.
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnits(t *testing.T) {
+	require.Equal(t, Length("1.5px"), Px(1.5))
+	require.Equal(t, Length("1.5em"), Em(1.5))
+	require.Equal(t, Length("1.5rem"), Rem(1.5))
+	require.Equal(t, Length("1.5%"), Percent(1.5))
+	require.Equal(t, Length("1.5vw"), Vw(1.5))
+	require.Equal(t, Length("1.5vh"), Vh(1.5))
+	require.Equal(t, Length("1.5vmin"), Vmin(1.5))
+	require.Equal(t, Length("1.5vmax"), Vmax(1.5))
+	require.Equal(t, Length("1.5dvh"), Dvh(1.5))
+	require.Equal(t, Length("1.5ch"), Ch(1.5))
+	require.Equal(t, Length("1.5ex"), Ex(1.5))
+	require.Equal(t, Length("1.5pt"), Pt(1.5))
+	require.Equal(t, Length("1.5fr"), Fr(1.5))
+}
+
+func TestProperties(t *testing.T) {
+	require.Equal(t, "red", Styles().AccentColor(Red)["accent-color"])
+	require.Equal(t, "normal", Styles().AlignContent(AlignContentNormal)["align-content"])
+	require.Equal(t, "normal", Styles().AlignItems(AlignItemsNormal)["align-items"])
+	require.Equal(t, "auto", Styles().AlignSelf(AlignSelfAuto)["align-self"])
+	require.Equal(t, "foo", Styles().Animation("foo")["animation"])
+	require.Equal(t, "foo", Styles().Background("foo")["background"])
+	require.Equal(t, "red", Styles().BackgroundColor(Red)["background-color"])
+	require.Equal(t, "foo", Styles().BackgroundImage("foo")["background-image"])
+	require.Equal(t, "red", Styles().BorderColor(Red)["border-color"])
+	require.Equal(t, "1px auto", Styles().BorderRadius(Px(1), Auto)["border-radius"])
+	require.Equal(t, "none", Styles().BorderStyle(BorderStyleNone)["border-style"])
+	require.Equal(t, "1px auto", Styles().BorderWidth(Px(1), Auto)["border-width"])
+	require.Equal(t, "42px", Styles().Bottom(Px(42))["bottom"])
+	require.Equal(t, "foo", Styles().BoxShadow("foo")["box-shadow"])
+	require.Equal(t, "content-box", Styles().BoxSizing(BoxSizingContentBox)["box-sizing"])
+	require.Equal(t, "red", Styles().CaretColor(Red)["caret-color"])
+	require.Equal(t, "none", Styles().Clear(ClearNone)["clear"])
+	require.Equal(t, "red", Styles().Color(Red)["color"])
+	require.Equal(t, "42px", Styles().ColumnGap(Px(42))["column-gap"])
+	require.Equal(t, "auto", Styles().Cursor(CursorAuto)["cursor"])
+	require.Equal(t, "none", Styles().Display(DisplayNone)["display"])
+	require.Equal(t, "red", Styles().Fill(Red)["fill"])
+	require.Equal(t, "foo", Styles().Filter("foo")["filter"])
+	require.Equal(t, "42px", Styles().FlexBasis(Px(42))["flex-basis"])
+	require.Equal(t, "row", Styles().FlexDirection(FlexDirectionRow)["flex-direction"])
+	require.Equal(t, "0.5", Styles().FlexGrow(0.5)["flex-grow"])
+	require.Equal(t, "0.5", Styles().FlexShrink(0.5)["flex-shrink"])
+	require.Equal(t, "nowrap", Styles().FlexWrap(FlexWrapNowrap)["flex-wrap"])
+	require.Equal(t, "none", Styles().Float(FloatNone)["float"])
+	require.Equal(t, "42px", Styles().FontSize(Px(42))["font-size"])
+	require.Equal(t, "normal", Styles().FontStyle(FontStyleNormal)["font-style"])
+	require.Equal(t, "normal", Styles().FontWeight(FontWeightNormal)["font-weight"])
+	require.Equal(t, "1px auto", Styles().Gap(Px(1), Auto)["gap"])
+	require.Equal(t, "foo", Styles().GridArea("foo")["grid-area"])
+	require.Equal(t, "1px auto", Styles().GridAutoColumns(Px(1), Auto)["grid-auto-columns"])
+	require.Equal(t, "row", Styles().GridAutoFlow(GridAutoFlowRow)["grid-auto-flow"])
+	require.Equal(t, "1px auto", Styles().GridAutoRows(Px(1), Auto)["grid-auto-rows"])
+	require.Equal(t, "foo", Styles().GridColumn("foo")["grid-column"])
+	require.Equal(t, "foo", Styles().GridRow("foo")["grid-row"])
+	require.Equal(t, "1px auto", Styles().GridTemplateColumns(Px(1), Auto)["grid-template-columns"])
+	require.Equal(t, "1px auto", Styles().GridTemplateRows(Px(1), Auto)["grid-template-rows"])
+	require.Equal(t, "42px", Styles().Height(Px(42))["height"])
+	require.Equal(t, "1px auto", Styles().Inset(Px(1), Auto)["inset"])
+	require.Equal(t, "normal", Styles().JustifyContent(JustifyContentNormal)["justify-content"])
+	require.Equal(t, "normal", Styles().JustifyItems(JustifyItemsNormal)["justify-items"])
+	require.Equal(t, "auto", Styles().JustifySelf(JustifySelfAuto)["justify-self"])
+	require.Equal(t, "42px", Styles().Left(Px(42))["left"])
+	require.Equal(t, "42px", Styles().LetterSpacing(Px(42))["letter-spacing"])
+	require.Equal(t, "0.5", Styles().LineHeight(0.5)["line-height"])
+	require.Equal(t, "none", Styles().ListStyleType(ListStyleTypeNone)["list-style-type"])
+	require.Equal(t, "1px auto", Styles().Margin(Px(1), Auto)["margin"])
+	require.Equal(t, "42px", Styles().MarginBottom(Px(42))["margin-bottom"])
+	require.Equal(t, "42px", Styles().MarginLeft(Px(42))["margin-left"])
+	require.Equal(t, "42px", Styles().MarginRight(Px(42))["margin-right"])
+	require.Equal(t, "42px", Styles().MarginTop(Px(42))["margin-top"])
+	require.Equal(t, "42px", Styles().MaxHeight(Px(42))["max-height"])
+	require.Equal(t, "42px", Styles().MaxWidth(Px(42))["max-width"])
+	require.Equal(t, "42px", Styles().MinHeight(Px(42))["min-height"])
+	require.Equal(t, "42px", Styles().MinWidth(Px(42))["min-width"])
+	require.Equal(t, "fill", Styles().ObjectFit(ObjectFitFill)["object-fit"])
+	require.Equal(t, "0.5", Styles().Opacity(0.5)["opacity"])
+	require.Equal(t, "42", Styles().Order(42)["order"])
+	require.Equal(t, "red", Styles().OutlineColor(Red)["outline-color"])
+	require.Equal(t, "42px", Styles().OutlineOffset(Px(42))["outline-offset"])
+	require.Equal(t, "none", Styles().OutlineStyle(BorderStyleNone)["outline-style"])
+	require.Equal(t, "42px", Styles().OutlineWidth(Px(42))["outline-width"])
+	require.Equal(t, "visible", Styles().Overflow(OverflowVisible)["overflow"])
+	require.Equal(t, "visible", Styles().OverflowX(OverflowVisible)["overflow-x"])
+	require.Equal(t, "visible", Styles().OverflowY(OverflowVisible)["overflow-y"])
+	require.Equal(t, "1px auto", Styles().Padding(Px(1), Auto)["padding"])
+	require.Equal(t, "42px", Styles().PaddingBottom(Px(42))["padding-bottom"])
+	require.Equal(t, "42px", Styles().PaddingLeft(Px(42))["padding-left"])
+	require.Equal(t, "42px", Styles().PaddingRight(Px(42))["padding-right"])
+	require.Equal(t, "42px", Styles().PaddingTop(Px(42))["padding-top"])
+	require.Equal(t, "auto", Styles().PointerEvents(PointerEventsAuto)["pointer-events"])
+	require.Equal(t, "static", Styles().Position(PositionStatic)["position"])
+	require.Equal(t, "none", Styles().Resize(ResizeNone)["resize"])
+	require.Equal(t, "42px", Styles().Right(Px(42))["right"])
+	require.Equal(t, "42px", Styles().RowGap(Px(42))["row-gap"])
+	require.Equal(t, "auto", Styles().ScrollBehavior(ScrollBehaviorAuto)["scroll-behavior"])
+	require.Equal(t, "red", Styles().Stroke(Red)["stroke"])
+	require.Equal(t, "start", Styles().TextAlign(TextAlignStart)["text-align"])
+	require.Equal(t, "none", Styles().TextDecorationLine(TextDecorationLineNone)["text-decoration-line"])
+	require.Equal(t, "42px", Styles().TextIndent(Px(42))["text-indent"])
+	require.Equal(t, "clip", Styles().TextOverflow(TextOverflowClip)["text-overflow"])
+	require.Equal(t, "none", Styles().TextTransform(TextTransformNone)["text-transform"])
+	require.Equal(t, "42px", Styles().Top(Px(42))["top"])
+	require.Equal(t, "foo", Styles().Transform("foo")["transform"])
+	require.Equal(t, "foo", Styles().Transition("foo")["transition"])
+	require.Equal(t, "auto", Styles().UserSelect(UserSelectAuto)["user-select"])
+	require.Equal(t, "baseline", Styles().VerticalAlign(VerticalAlignBaseline)["vertical-align"])
+	require.Equal(t, "visible", Styles().Visibility(VisibilityVisible)["visibility"])
+	require.Equal(t, "normal", Styles().WhiteSpace(WhiteSpaceNormal)["white-space"])
+	require.Equal(t, "42px", Styles().Width(Px(42))["width"])
+	require.Equal(t, "normal", Styles().WordBreak(WordBreakNormal)["word-break"])
+	require.Equal(t, "42", Styles().ZIndex(42)["z-index"])
+}
@@ -0,0 +1,104 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/app"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		s := Styles().
+			Display(DisplayFlex).
+			Color(Red).
+			Set("--gap", "4px")
+		require.Equal(t, "--gap:4px;color:red;display:flex", s.String())
+	})
+
+	t.Run("shorthands", func(t *testing.T) {
+		s := Styles().
+			Border(Px(1), BorderStyleSolid, Black).
+			Flex(1, 0, Auto).
+			FontFamily("Montserrat", "sans-serif").
+			GridTemplateAreas("header header", "nav main").
+			Content("→")
+
+		require.Equal(t, "1px solid black", s["border"])
+		require.Equal(t, "1 0 auto", s["flex"])
+		require.Equal(t, `"Montserrat", sans-serif`, s["font-family"])
+		require.Equal(t, `"header header" "nav main"`, s["grid-template-areas"])
+		require.Equal(t, `"→"`, s["content"])
+	})
+
+	t.Run("strings are escaped", func(t *testing.T) {
+		s := Styles().
+			FontFamily(`Font "Pro"`, `a\b`).
+			Content("line\nbreak\x7f")
+
+		require.Equal(t, `"Font \"Pro\"", "a\\b"`, s["font-family"])
+		require.Equal(t, `"line\a break\7f "`, s["content"])
+	})
+
+	t.Run("element styles", func(t *testing.T) {
+		div := app.Div().Styles(Styles().
+			Width(Percent(50)).
+			Display(DisplayGrid),
+		)
+		require.Equal(t, `<div style="display:grid;width:50%;"></div>`, app.HTMLString(div))
+	})
+}
+
+func TestLength(t *testing.T) {
+	require.Equal(t, Length("calc(100% - 48px)"), Calc("100%% - %s", Px(48)))
+	require.Equal(t, Length("repeat(3, minmax(0, 1fr))"), Repeat(3, MinMax(Zero, Fr(1))))
+	require.Equal(t, Length("0.25rem"), Rem(0.25))
+	require.Equal(t, Length("var(--spacing)"), Var[Length]("--spacing"))
+}
+
+func TestColor(t *testing.T) {
+	require.Equal(t, Color("rgb(45, 44, 44)"), RGB(45, 44, 44))
+	require.Equal(t, Color("rgba(0, 0, 0, 0.4)"), RGBA(0, 0, 0, 0.4))
+	require.Equal(t, Color("hsl(210, 50%, 40.5%)"), HSL(210, 50, 40.5))
+	require.Equal(t, Color("hsla(210, 50%, 40%, 1)"), HSLA(210, 50, 40, 1))
+	require.Equal(t, Color("#ff0000"), Hex("ff0000"))
+	require.Equal(t, Color("#ff0000"), Hex("#ff0000"))
+	require.Equal(t, Color("var(--primary)"), Var[Color]("--primary"))
+	require.Equal(t, Color("currentcolor"), CurrentColor)
+}
+
+func TestMediaQuery(t *testing.T) {
+	require.Equal(t, MediaQuery("screen and (min-width: 480px)"), And(Screen, MinWidth(Px(480))))
+	require.Equal(t, MediaQuery("(max-height: 600px), print"), Or(MaxHeight(Px(600)), Print))
+	require.Equal(t,
+		MediaQuery("screen and (hover: hover), print and (hover: hover)"),
+		And(Or(Screen, Print), Hover),
+	)
+	require.Equal(t,
+		MediaQuery("screen and (min-width: 480px), screen and (hover: hover)"),
+		And(Screen, Or(MinWidth(Px(480)), Hover)),
+	)
+}
+
+func TestStylesheet(t *testing.T) {
+	css := Stylesheet(
+		Select(".btn", Styles().
+			Padding(Px(6), Px(12)).
+			Cursor(CursorPointer),
+		),
+		Media(And(Screen, MaxWidth(Px(480))),
+			Select(".btn", Styles().Width(Percent(100))),
+		),
+	)
+
+	require.Equal(t, `.btn {
+  cursor: pointer;
+  padding: 6px 12px;
+}
+@media screen and (max-width: 480px) {
+  .btn {
+    width: 100%;
+  }
+}
+`, css)
+}
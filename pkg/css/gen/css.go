@@ -0,0 +1,566 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+type unit struct {
+	Name   string
+	Suffix string
+	Doc    string
+}
+
+var units = []unit{
+	{Name: "Px", Suffix: "px", Doc: "pixels"},
+	{Name: "Em", Suffix: "em", Doc: "multiples of the font size of the element"},
+	{Name: "Rem", Suffix: "rem", Doc: "multiples of the font size of the root element"},
+	{Name: "Percent", Suffix: "%", Doc: "percents of the parent element size"},
+	{Name: "Vw", Suffix: "vw", Doc: "percents of the viewport width"},
+	{Name: "Vh", Suffix: "vh", Doc: "percents of the viewport height"},
+	{Name: "Vmin", Suffix: "vmin", Doc: "percents of the smallest viewport dimension"},
+	{Name: "Vmax", Suffix: "vmax", Doc: "percents of the largest viewport dimension"},
+	{Name: "Dvh", Suffix: "dvh", Doc: "percents of the dynamic viewport height"},
+	{Name: "Ch", Suffix: "ch", Doc: "widths of the \"0\" character of the element font"},
+	{Name: "Ex", Suffix: "ex", Doc: "heights of the \"x\" character of the element font"},
+	{Name: "Pt", Suffix: "pt", Doc: "points"},
+	{Name: "Fr", Suffix: "fr", Doc: "fractions of the free space of a grid container"},
+}
+
+type keywordType struct {
+	Name   string
+	Doc    string
+	Values []string
+}
+
+var keywordTypes = []keywordType{
+	{
+		Name:   "AlignContent",
+		Doc:    "how the space between and around the lines of a flex or grid container is distributed along its cross axis",
+		Values: []string{"normal", "start", "end", "center", "flex-start", "flex-end", "space-between", "space-around", "space-evenly", "stretch"},
+	},
+	{
+		Name:   "AlignItems",
+		Doc:    "how the items of a flex or grid container are aligned along its cross axis",
+		Values: []string{"normal", "stretch", "center", "start", "end", "flex-start", "flex-end", "baseline"},
+	},
+	{
+		Name:   "AlignSelf",
+		Doc:    "how an item is aligned along the cross axis of its flex or grid container",
+		Values: []string{"auto", "normal", "stretch", "center", "start", "end", "flex-start", "flex-end", "baseline"},
+	},
+	{
+		Name:   "BorderStyle",
+		Doc:    "the line style of a border",
+		Values: []string{"none", "hidden", "dotted", "dashed", "solid", "double", "groove", "ridge", "inset", "outset"},
+	},
+	{
+		Name:   "BoxSizing",
+		Doc:    "how the width and height of an element are calculated",
+		Values: []string{"content-box", "border-box"},
+	},
+	{
+		Name:   "Clear",
+		Doc:    "whether an element is moved below the floating elements that precede it",
+		Values: []string{"none", "left", "right", "both"},
+	},
+	{
+		Name:   "Cursor",
+		Doc:    "the mouse cursor displayed when the pointer is over an element",
+		Values: []string{"auto", "default", "none", "pointer", "text", "move", "not-allowed", "grab", "grabbing", "wait", "progress", "help", "crosshair", "zoom-in", "zoom-out", "col-resize", "row-resize"},
+	},
+	{
+		Name:   "Display",
+		Doc:    "how an element and its children are laid out",
+		Values: []string{"none", "block", "inline", "inline-block", "flex", "inline-flex", "grid", "inline-grid", "contents", "table", "table-row", "table-cell", "list-item"},
+	},
+	{
+		Name:   "FlexDirection",
+		Doc:    "the direction of the main axis of a flex container",
+		Values: []string{"row", "row-reverse", "column", "column-reverse"},
+	},
+	{
+		Name:   "FlexWrap",
+		Doc:    "whether the items of a flex container are wrapped onto multiple lines",
+		Values: []string{"nowrap", "wrap", "wrap-reverse"},
+	},
+	{
+		Name:   "Float",
+		Doc:    "the side of its container where an element is placed",
+		Values: []string{"none", "left", "right", "inline-start", "inline-end"},
+	},
+	{
+		Name:   "FontStyle",
+		Doc:    "whether a font is styled with a normal, italic or oblique face",
+		Values: []string{"normal", "italic", "oblique"},
+	},
+	{
+		Name:   "FontWeight",
+		Doc:    "the weight of a font",
+		Values: []string{"normal", "bold", "bolder", "lighter", "100", "200", "300", "400", "500", "600", "700", "800", "900"},
+	},
+	{
+		Name:   "GridAutoFlow",
+		Doc:    "how the items that are not explicitly placed are flowed into a grid container",
+		Values: []string{"row", "column", "dense", "row dense", "column dense"},
+	},
+	{
+		Name:   "JustifyContent",
+		Doc:    "how the space between and around the items of a flex or grid container is distributed along its main axis",
+		Values: []string{"normal", "start", "end", "center", "flex-start", "flex-end", "left", "right", "space-between", "space-around", "space-evenly", "stretch"},
+	},
+	{
+		Name:   "JustifyItems",
+		Doc:    "how the items of a grid container are aligned along its inline axis",
+		Values: []string{"normal", "stretch", "center", "start", "end", "left", "right", "baseline"},
+	},
+	{
+		Name:   "JustifySelf",
+		Doc:    "how an item is aligned along the inline axis of its grid container",
+		Values: []string{"auto", "normal", "stretch", "center", "start", "end", "left", "right", "baseline"},
+	},
+	{
+		Name:   "ListStyleType",
+		Doc:    "the marker of a list item",
+		Values: []string{"none", "disc", "circle", "square", "decimal"},
+	},
+	{
+		Name:   "ObjectFit",
+		Doc:    "how the content of a replaced element, such as an image, is resized to fit its box",
+		Values: []string{"fill", "contain", "cover", "none", "scale-down"},
+	},
+	{
+		Name:   "Overflow",
+		Doc:    "what happens when the content of an element is too big to fit in its box",
+		Values: []string{"visible", "hidden", "clip", "scroll", "auto"},
+	},
+	{
+		Name:   "PointerEvents",
+		Doc:    "whether an element can be the target of pointer events",
+		Values: []string{"auto", "none"},
+	},
+	{
+		Name:   "Position",
+		Doc:    "how an element is positioned in the document",
+		Values: []string{"static", "relative", "absolute", "fixed", "sticky"},
+	},
+	{
+		Name:   "Resize",
+		Doc:    "whether and in which directions an element is resizable by the user",
+		Values: []string{"none", "both", "horizontal", "vertical"},
+	},
+	{
+		Name:   "ScrollBehavior",
+		Doc:    "the scrolling behavior of a scroll container",
+		Values: []string{"auto", "smooth"},
+	},
+	{
+		Name:   "TextAlign",
+		Doc:    "the horizontal alignment of the inline content of a block",
+		Values: []string{"start", "end", "left", "right", "center", "justify"},
+	},
+	{
+		Name:   "TextDecorationLine",
+		Doc:    "the kind of decoration of a text",
+		Values: []string{"none", "underline", "overline", "line-through"},
+	},
+	{
+		Name:   "TextOverflow",
+		Doc:    "how the overflowing content of a text is signaled to the user",
+		Values: []string{"clip", "ellipsis"},
+	},
+	{
+		Name:   "TextTransform",
+		Doc:    "the capitalization of a text",
+		Values: []string{"none", "capitalize", "uppercase", "lowercase"},
+	},
+	{
+		Name:   "UserSelect",
+		Doc:    "whether the text of an element can be selected by the user",
+		Values: []string{"auto", "none", "text", "all"},
+	},
+	{
+		Name:   "VerticalAlign",
+		Doc:    "the vertical alignment of an inline or table cell element",
+		Values: []string{"baseline", "sub", "super", "text-top", "text-bottom", "middle", "top", "bottom"},
+	},
+	{
+		Name:   "Visibility",
+		Doc:    "whether an element is visible, without changing the layout",
+		Values: []string{"visible", "hidden", "collapse"},
+	},
+	{
+		Name:   "WhiteSpace",
+		Doc:    "how the white spaces of a text are handled",
+		Values: []string{"normal", "nowrap", "pre", "pre-wrap", "pre-line", "break-spaces"},
+	},
+	{
+		Name:   "WordBreak",
+		Doc:    "where line breaks are inserted in a text that would overflow its box",
+		Values: []string{"normal", "break-all", "keep-all", "break-word"},
+	},
+}
+
+type property struct {
+	Name string
+	Type string
+	Doc  string
+}
+
+// The properties, indexed by CSS name. Types are Length, ...Length (space
+// separated lengths, such as shorthands or grid track lists), Color, float64,
+// int, string or the name of a keyword type.
+var properties = map[string]property{
+	// Box:
+	"width":      {Name: "Width", Type: "Length", Doc: "the width of an element"},
+	"height":     {Name: "Height", Type: "Length", Doc: "the height of an element"},
+	"min-width":  {Name: "MinWidth", Type: "Length", Doc: "the minimum width of an element"},
+	"max-width":  {Name: "MaxWidth", Type: "Length", Doc: "the maximum width of an element"},
+	"min-height": {Name: "MinHeight", Type: "Length", Doc: "the minimum height of an element"},
+	"max-height": {Name: "MaxHeight", Type: "Length", Doc: "the maximum height of an element"},
+	"box-sizing": {Name: "BoxSizing", Type: "BoxSizing", Doc: "how the width and height of an element are calculated"},
+	"display":    {Name: "Display", Type: "Display", Doc: "how an element and its children are laid out"},
+	"overflow":   {Name: "Overflow", Type: "Overflow", Doc: "what happens when the content of an element is too big to fit in its box"},
+	"overflow-x": {Name: "OverflowX", Type: "Overflow", Doc: "what happens when the content of an element is too wide to fit in its box"},
+	"overflow-y": {Name: "OverflowY", Type: "Overflow", Doc: "what happens when the content of an element is too high to fit in its box"},
+	"visibility": {Name: "Visibility", Type: "Visibility", Doc: "whether an element is visible, without changing the layout"},
+	"opacity":    {Name: "Opacity", Type: "float64", Doc: "the opacity of an element, between 0 and 1"},
+	"object-fit": {Name: "ObjectFit", Type: "ObjectFit", Doc: "how the content of a replaced element is resized to fit its box"},
+
+	// Spacing:
+	"margin":         {Name: "Margin", Type: "...Length", Doc: "the margins of an element, in the top, right, bottom and left order"},
+	"margin-top":     {Name: "MarginTop", Type: "Length", Doc: "the top margin of an element"},
+	"margin-right":   {Name: "MarginRight", Type: "Length", Doc: "the right margin of an element"},
+	"margin-bottom":  {Name: "MarginBottom", Type: "Length", Doc: "the bottom margin of an element"},
+	"margin-left":    {Name: "MarginLeft", Type: "Length", Doc: "the left margin of an element"},
+	"padding":        {Name: "Padding", Type: "...Length", Doc: "the paddings of an element, in the top, right, bottom and left order"},
+	"padding-top":    {Name: "PaddingTop", Type: "Length", Doc: "the top padding of an element"},
+	"padding-right":  {Name: "PaddingRight", Type: "Length", Doc: "the right padding of an element"},
+	"padding-bottom": {Name: "PaddingBottom", Type: "Length", Doc: "the bottom padding of an element"},
+	"padding-left":   {Name: "PaddingLeft", Type: "Length", Doc: "the left padding of an element"},
+
+	// Position:
+	"position": {Name: "Position", Type: "Position", Doc: "how an element is positioned in the document"},
+	"inset":    {Name: "Inset", Type: "...Length", Doc: "the top, right, bottom and left offsets of a positioned element"},
+	"top":      {Name: "Top", Type: "Length", Doc: "the top offset of a positioned element"},
+	"right":    {Name: "Right", Type: "Length", Doc: "the right offset of a positioned element"},
+	"bottom":   {Name: "Bottom", Type: "Length", Doc: "the bottom offset of a positioned element"},
+	"left":     {Name: "Left", Type: "Length", Doc: "the left offset of a positioned element"},
+	"z-index":  {Name: "ZIndex", Type: "int", Doc: "the stack order of a positioned element"},
+	"float":    {Name: "Float", Type: "Float", Doc: "the side of its container where an element is placed"},
+	"clear":    {Name: "Clear", Type: "Clear", Doc: "whether an element is moved below the floating elements that precede it"},
+
+	// Flex:
+	"flex-direction": {Name: "FlexDirection", Type: "FlexDirection", Doc: "the direction of the main axis of a flex container"},
+	"flex-wrap":      {Name: "FlexWrap", Type: "FlexWrap", Doc: "whether the items of a flex container are wrapped onto multiple lines"},
+	"flex-grow":      {Name: "FlexGrow", Type: "float64", Doc: "how much a flex item grows relative to the other items"},
+	"flex-shrink":    {Name: "FlexShrink", Type: "float64", Doc: "how much a flex item shrinks relative to the other items"},
+	"flex-basis":     {Name: "FlexBasis", Type: "Length", Doc: "the initial main size of a flex item"},
+	"order":          {Name: "Order", Type: "int", Doc: "the order of an item in its flex or grid container"},
+
+	// Alignment:
+	"justify-content": {Name: "JustifyContent", Type: "JustifyContent", Doc: "how the space between and around the items of a container is distributed along its main axis"},
+	"justify-items":   {Name: "JustifyItems", Type: "JustifyItems", Doc: "how the items of a grid container are aligned along its inline axis"},
+	"justify-self":    {Name: "JustifySelf", Type: "JustifySelf", Doc: "how an item is aligned along the inline axis of its grid container"},
+	"align-content":   {Name: "AlignContent", Type: "AlignContent", Doc: "how the space between and around the lines of a container is distributed along its cross axis"},
+	"align-items":     {Name: "AlignItems", Type: "AlignItems", Doc: "how the items of a container are aligned along its cross axis"},
+	"align-self":      {Name: "AlignSelf", Type: "AlignSelf", Doc: "how an item is aligned along the cross axis of its container"},
+	"gap":             {Name: "Gap", Type: "...Length", Doc: "the gaps between the rows and the columns of a flex or grid container"},
+	"row-gap":         {Name: "RowGap", Type: "Length", Doc: "the gap between the rows of a flex or grid container"},
+	"column-gap":      {Name: "ColumnGap", Type: "Length", Doc: "the gap between the columns of a flex or grid container"},
+
+	// Grid:
+	"grid-template-columns": {Name: "GridTemplateColumns", Type: "...Length", Doc: "the sizes of the columns of a grid container"},
+	"grid-template-rows":    {Name: "GridTemplateRows", Type: "...Length", Doc: "the sizes of the rows of a grid container"},
+	"grid-auto-columns":     {Name: "GridAutoColumns", Type: "...Length", Doc: "the sizes of the implicitly created columns of a grid container"},
+	"grid-auto-rows":        {Name: "GridAutoRows", Type: "...Length", Doc: "the sizes of the implicitly created rows of a grid container"},
+	"grid-auto-flow":        {Name: "GridAutoFlow", Type: "GridAutoFlow", Doc: "how the items that are not explicitly placed are flowed into a grid container"},
+	"grid-area":             {Name: "GridArea", Type: "string", Doc: "the placement of an item in a grid container, such as a named area"},
+	"grid-column":           {Name: "GridColumn", Type: "string", Doc: "the placement of an item in the columns of a grid container, such as \"1 / 3\""},
+	"grid-row":              {Name: "GridRow", Type: "string", Doc: "the placement of an item in the rows of a grid container, such as \"1 / span 2\""},
+
+	// Text:
+	"color":                {Name: "Color", Type: "Color", Doc: "the color of the text of an element"},
+	"font-size":            {Name: "FontSize", Type: "Length", Doc: "the size of a font"},
+	"font-style":           {Name: "FontStyle", Type: "FontStyle", Doc: "whether a font is styled with a normal, italic or oblique face"},
+	"font-weight":          {Name: "FontWeight", Type: "FontWeight", Doc: "the weight of a font"},
+	"line-height":          {Name: "LineHeight", Type: "float64", Doc: "the height of a line box, as a multiple of the font size"},
+	"letter-spacing":       {Name: "LetterSpacing", Type: "Length", Doc: "the space between the characters of a text"},
+	"text-align":           {Name: "TextAlign", Type: "TextAlign", Doc: "the horizontal alignment of the inline content of a block"},
+	"text-decoration-line": {Name: "TextDecorationLine", Type: "TextDecorationLine", Doc: "the kind of decoration of a text"},
+	"text-indent":          {Name: "TextIndent", Type: "Length", Doc: "the indentation of the first line of a text"},
+	"text-overflow":        {Name: "TextOverflow", Type: "TextOverflow", Doc: "how the overflowing content of a text is signaled to the user"},
+	"text-transform":       {Name: "TextTransform", Type: "TextTransform", Doc: "the capitalization of a text"},
+	"vertical-align":       {Name: "VerticalAlign", Type: "VerticalAlign", Doc: "the vertical alignment of an inline or table cell element"},
+	"white-space":          {Name: "WhiteSpace", Type: "WhiteSpace", Doc: "how the white spaces of a text are handled"},
+	"word-break":           {Name: "WordBreak", Type: "WordBreak", Doc: "where line breaks are inserted in a text that would overflow its box"},
+	"list-style-type":      {Name: "ListStyleType", Type: "ListStyleType", Doc: "the marker of a list item"},
+
+	// Background and borders:
+	"background":       {Name: "Background", Type: "string", Doc: "all the background properties of an element"},
+	"background-color": {Name: "BackgroundColor", Type: "Color", Doc: "the background color of an element"},
+	"background-image": {Name: "BackgroundImage", Type: "string", Doc: "the background images of an element, such as \"url(/web/bg.png)\""},
+	"border-color":     {Name: "BorderColor", Type: "Color", Doc: "the color of the borders of an element"},
+	"border-style":     {Name: "BorderStyle", Type: "BorderStyle", Doc: "the line style of the borders of an element"},
+	"border-width":     {Name: "BorderWidth", Type: "...Length", Doc: "the widths of the borders of an element, in the top, right, bottom and left order"},
+	"border-radius":    {Name: "BorderRadius", Type: "...Length", Doc: "the radius of the corners of an element, in the top-left, top-right, bottom-right and bottom-left order"},
+	"box-shadow":       {Name: "BoxShadow", Type: "string", Doc: "the shadows of an element, such as \"0 1px 2px rgba(0, 0, 0, 0.2)\""},
+	"outline-color":    {Name: "OutlineColor", Type: "Color", Doc: "the color of the outline of an element"},
+	"outline-style":    {Name: "OutlineStyle", Type: "BorderStyle", Doc: "the line style of the outline of an element"},
+	"outline-width":    {Name: "OutlineWidth", Type: "Length", Doc: "the width of the outline of an element"},
+	"outline-offset":   {Name: "OutlineOffset", Type: "Length", Doc: "the space between the outline and the border of an element"},
+
+	// SVG:
+	"fill":   {Name: "Fill", Type: "Color", Doc: "the color used to paint the interior of an SVG shape"},
+	"stroke": {Name: "Stroke", Type: "Color", Doc: "the color used to paint the outline of an SVG shape"},
+
+	// Interaction:
+	"cursor":          {Name: "Cursor", Type: "Cursor", Doc: "the mouse cursor displayed when the pointer is over an element"},
+	"pointer-events":  {Name: "PointerEvents", Type: "PointerEvents", Doc: "whether an element can be the target of pointer events"},
+	"user-select":     {Name: "UserSelect", Type: "UserSelect", Doc: "whether the text of an element can be selected by the user"},
+	"resize":          {Name: "Resize", Type: "Resize", Doc: "whether and in which directions an element is resizable by the user"},
+	"scroll-behavior": {Name: "ScrollBehavior", Type: "ScrollBehavior", Doc: "the scrolling behavior of a scroll container"},
+	"accent-color":    {Name: "AccentColor", Type: "Color", Doc: "the color of the user interface controls, such as checkboxes"},
+	"caret-color":     {Name: "CaretColor", Type: "Color", Doc: "the color of the insertion caret of an editable element"},
+
+	// Effects:
+	"transform":  {Name: "Transform", Type: "string", Doc: "the transformations of an element, such as \"rotate(45deg)\""},
+	"transition": {Name: "Transition", Type: "string", Doc: "the transitions of an element, such as \"opacity 0.3s ease\""},
+	"animation":  {Name: "Animation", Type: "string", Doc: "the animations of an element, such as \"spin 1s linear infinite\""},
+	"filter":     {Name: "Filter", Type: "string", Doc: "the graphical effects of an element, such as \"blur(2px)\""},
+}
+
+// The CSS named colors.
+var colors = []string{
+	"AliceBlue", "AntiqueWhite", "Aqua", "Aquamarine", "Azure", "Beige",
+	"Bisque", "Black", "BlanchedAlmond", "Blue", "BlueViolet", "Brown",
+	"BurlyWood", "CadetBlue", "Chartreuse", "Chocolate", "Coral",
+	"CornflowerBlue", "Cornsilk", "Crimson", "Cyan", "DarkBlue", "DarkCyan",
+	"DarkGoldenRod", "DarkGray", "DarkGreen", "DarkKhaki", "DarkMagenta",
+	"DarkOliveGreen", "DarkOrange", "DarkOrchid", "DarkRed", "DarkSalmon",
+	"DarkSeaGreen", "DarkSlateBlue", "DarkSlateGray", "DarkTurquoise",
+	"DarkViolet", "DeepPink", "DeepSkyBlue", "DimGray", "DodgerBlue",
+	"FireBrick", "FloralWhite", "ForestGreen", "Fuchsia", "Gainsboro",
+	"GhostWhite", "Gold", "GoldenRod", "Gray", "Green", "GreenYellow",
+	"HoneyDew", "HotPink", "IndianRed", "Indigo", "Ivory", "Khaki", "Lavender",
+	"LavenderBlush", "LawnGreen", "LemonChiffon", "LightBlue", "LightCoral",
+	"LightCyan", "LightGoldenRodYellow", "LightGray", "LightGreen",
+	"LightPink", "LightSalmon", "LightSeaGreen", "LightSkyBlue",
+	"LightSlateGray", "LightSteelBlue", "LightYellow", "Lime", "LimeGreen",
+	"Linen", "Magenta", "Maroon", "MediumAquaMarine", "MediumBlue",
+	"MediumOrchid", "MediumPurple", "MediumSeaGreen", "MediumSlateBlue",
+	"MediumSpringGreen", "MediumTurquoise", "MediumVioletRed", "MidnightBlue",
+	"MintCream", "MistyRose", "Moccasin", "NavajoWhite", "Navy", "OldLace",
+	"Olive", "OliveDrab", "Orange", "OrangeRed", "Orchid", "PaleGoldenRod",
+	"PaleGreen", "PaleTurquoise", "PaleVioletRed", "PapayaWhip", "PeachPuff",
+	"Peru", "Pink", "Plum", "PowderBlue", "Purple", "RebeccaPurple", "Red",
+	"RosyBrown", "RoyalBlue", "SaddleBrown", "Salmon", "SandyBrown",
+	"SeaGreen", "SeaShell", "Sienna", "Silver", "SkyBlue", "SlateBlue",
+	"SlateGray", "Snow", "SpringGreen", "SteelBlue", "Tan", "Teal", "Thistle",
+	"Tomato", "Transparent", "Turquoise", "Violet", "Wheat", "White",
+	"WhiteSmoke", "Yellow", "YellowGreen", "CurrentColor",
+}
+
+func main() {
+	generateCSSGo()
+	generateCSSTestGo()
+}
+
+func generateCSSGo() {
+	f, err := os.Create("css_gen.go")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	fmt.Fprintln(f, "package css")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// Code generated by go generate; DO NOT EDIT.")
+	fmt.Fprintln(f, `
+import (
+	"strconv"
+)
+		`)
+
+	for _, u := range units {
+		fmt.Fprintf(f, `
+			// %s returns a length in %s.
+			func %s(v float64) Length {
+				return Length(formatNumber(v) + "%s")
+			}
+			`,
+			u.Name,
+			u.Doc,
+			u.Name,
+			u.Suffix,
+		)
+	}
+
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// The named colors.")
+	fmt.Fprintln(f, "const (")
+	for _, c := range colors {
+		fmt.Fprintf(f, "%s Color = %q\n", c, strings.ToLower(c))
+	}
+	fmt.Fprintln(f, ")")
+
+	for _, t := range keywordTypes {
+		fmt.Fprintf(f, `
+			// %s represents %s.
+			type %s string
+
+			const (
+			`,
+			t.Name,
+			t.Doc,
+			t.Name,
+		)
+		for _, v := range t.Values {
+			fmt.Fprintf(f, "%s %s = %q\n", keywordName(t.Name, v), t.Name, v)
+		}
+		fmt.Fprintln(f, ")")
+	}
+
+	for _, k := range sortedProperties() {
+		writeProperty(f, k, properties[k])
+	}
+}
+
+func writeProperty(w io.Writer, name string, p property) {
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "// %s sets %s.\n", p.Name, p.Doc)
+
+	switch p.Type {
+	case "...Length":
+		fmt.Fprintf(w, `func (s Style) %s(v ...Length) Style {
+			s[%q] = joinLengths(v)
+			return s
+		}
+		`, p.Name, name)
+
+	case "float64":
+		fmt.Fprintf(w, `func (s Style) %s(v float64) Style {
+			s[%q] = formatNumber(v)
+			return s
+		}
+		`, p.Name, name)
+
+	case "int":
+		fmt.Fprintf(w, `func (s Style) %s(v int) Style {
+			s[%q] = strconv.Itoa(v)
+			return s
+		}
+		`, p.Name, name)
+
+	case "string":
+		fmt.Fprintf(w, `func (s Style) %s(v string) Style {
+			s[%q] = v
+			return s
+		}
+		`, p.Name, name)
+
+	default:
+		fmt.Fprintf(w, `func (s Style) %s(v %s) Style {
+			s[%q] = string(v)
+			return s
+		}
+		`, p.Name, p.Type, name)
+	}
+}
+
+func generateCSSTestGo() {
+	f, err := os.Create("css_gen_test.go")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	fmt.Fprintln(f, "package css")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// Code generated by go generate; DO NOT EDIT.")
+	fmt.Fprintln(f, `
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+		`)
+
+	fmt.Fprintln(f, "func TestUnits(t *testing.T) {")
+	for _, u := range units {
+		fmt.Fprintf(f, "require.Equal(t, Length(%q), %s(1.5))\n", "1.5"+u.Suffix, u.Name)
+	}
+	fmt.Fprintln(f, "}")
+
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "func TestProperties(t *testing.T) {")
+	for _, k := range sortedProperties() {
+		p := properties[k]
+
+		var arg, value string
+		switch p.Type {
+		case "Length":
+			arg, value = "Px(42)", "42px"
+
+		case "...Length":
+			arg, value = "Px(1), Auto", "1px auto"
+
+		case "Color":
+			arg, value = "Red", "red"
+
+		case "float64":
+			arg, value = "0.5", "0.5"
+
+		case "int":
+			arg, value = "42", "42"
+
+		case "string":
+			arg, value = `"foo"`, "foo"
+
+		default:
+			v := keywordValues(p.Type)[0]
+			arg, value = keywordName(p.Type, v), v
+		}
+
+		fmt.Fprintf(f, "require.Equal(t, %q, Styles().%s(%s)[%q])\n", value, p.Name, arg, k)
+	}
+	fmt.Fprintln(f, "}")
+}
+
+func sortedProperties() []string {
+	names := make([]string, 0, len(properties))
+	for k := range properties {
+		names = append(names, k)
+	}
+	for i := 1; i < len(names); i++ {
+		for j := i; j > 0 && properties[names[j]].Name < properties[names[j-1]].Name; j-- {
+			names[j], names[j-1] = names[j-1], names[j]
+		}
+	}
+	return names
+}
+
+func keywordValues(typeName string) []string {
+	for _, t := range keywordTypes {
+		if t.Name == typeName {
+			return t.Values
+		}
+	}
+	panic("unknown keyword type: " + typeName)
+}
+
+func keywordName(typeName, v string) string {
+	var b strings.Builder
+	b.WriteString(typeName)
+	for _, word := range strings.FieldsFunc(v, func(r rune) bool {
+		return r == '-' || r == ' '
+	}) {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
@@ -0,0 +1,238 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+// Catalog is a set of messages translated in several languages. Messages are
+// identified by a key, which is either an identifier such as "cart.title" or
+// the source text itself, as in gettext PO files.
+//
+// Messages are fmt format strings that are formatted with the arguments given
+// when they are translated. A message that depends on a quantity has a form
+// per plural category, such as One and Other in English.
+//
+// Catalogs are safe for concurrent use.
+type Catalog struct {
+	mutex    sync.RWMutex
+	fallback string
+	messages map[string]map[string]message
+}
+
+// message is a translated message. A message that depends on a quantity has
+// forms indexed by CLDR plural category or, when it comes from a PO file, by
+// the index returned by the plural expression of the file.
+type message struct {
+	text        string
+	plurals     map[PluralCategory]string
+	forms       []string
+	pluralIndex func(n int64) int64
+}
+
+// NewCatalog creates a catalog whose messages are translated in the given
+// fallback language when they are missing in the requested one.
+func NewCatalog(fallback string) *Catalog {
+	return &Catalog{
+		fallback: Canonical(fallback),
+		messages: make(map[string]map[string]message),
+	}
+}
+
+// Fallback returns the language used when a message is missing in the
+// requested one.
+func (c *Catalog) Fallback() string {
+	return c.fallback
+}
+
+// Languages returns the languages that have messages, sorted by tag. The
+// fallback language is always included.
+func (c *Catalog) Languages() []string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	languages := []string{c.fallback}
+	for lang := range c.messages {
+		if lang != c.fallback {
+			languages = append(languages, lang)
+		}
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Set sets the message with the given key in the given language.
+func (c *Catalog) Set(lang, key, text string) {
+	c.set(lang, key, message{text: text})
+}
+
+// SetPlural sets the message with the given key in the given language, with a
+// form per plural category. The Other form is used when the form of a category
+// is missing.
+func (c *Catalog) SetPlural(lang, key string, forms map[PluralCategory]string) {
+	plurals := make(map[PluralCategory]string, len(forms))
+	for category, form := range forms {
+		plurals[category] = form
+	}
+	c.set(lang, key, message{
+		text:    plurals[Other],
+		plurals: plurals,
+	})
+}
+
+func (c *Catalog) set(lang, key string, m message) {
+	lang = Canonical(lang)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	messages, ok := c.messages[lang]
+	if !ok {
+		messages = make(map[string]message)
+		c.messages[lang] = messages
+	}
+	messages[key] = m
+}
+
+// LoadJSON loads the messages of the given language from the given JSON
+// object. Plural messages are objects whose keys are plural categories, and
+// other nested objects are flattened by joining their keys with a dot:
+//
+//	{
+//	    "hello": "Hello %s",
+//	    "cart": {
+//	        "title": "Your cart",
+//	        "items": {
+//	            "one": "%d item",
+//	            "other": "%d items"
+//	        }
+//	    }
+//	}
+//
+// defines the "hello", "cart.title" and "cart.items" messages.
+func (c *Catalog) LoadJSON(lang string, data []byte) error {
+	var messages map[string]any
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return errors.New("decoding json messages failed").
+			WithTag("lang", lang).
+			Wrap(err)
+	}
+	return c.loadJSONMessages(lang, "", messages)
+}
+
+func (c *Catalog) loadJSONMessages(lang, prefix string, messages map[string]any) error {
+	for k, v := range messages {
+		key := prefix + k
+
+		switch v := v.(type) {
+		case string:
+			c.Set(lang, key, v)
+
+		case map[string]any:
+			if forms, ok := pluralForms(v); ok {
+				c.SetPlural(lang, key, forms)
+				continue
+			}
+			if err := c.loadJSONMessages(lang, key+".", v); err != nil {
+				return err
+			}
+
+		default:
+			return errors.New("json message is not a string or an object").
+				WithTag("lang", lang).
+				WithTag("key", key).
+				WithTag("type", fmt.Sprintf("%T", v))
+		}
+	}
+	return nil
+}
+
+// pluralForms returns the given JSON object as plural forms when all its keys
+// are plural categories and all its values are strings.
+func pluralForms(v map[string]any) (map[PluralCategory]string, bool) {
+	if len(v) == 0 {
+		return nil, false
+	}
+
+	forms := make(map[PluralCategory]string, len(v))
+	for k, v := range v {
+		switch category := PluralCategory(k); category {
+		case Zero, One, Two, Few, Many, Other:
+			form, ok := v.(string)
+			if !ok {
+				return nil, false
+			}
+			forms[category] = form
+
+		default:
+			return nil, false
+		}
+	}
+	return forms, true
+}
+
+// Translate returns the message with the given key in the given language,
+// formatted with the given arguments. The message in the fallback language is
+// used when it is missing in the given language. When the message is missing
+// in both, the key itself is returned, formatted when it contains verbs like a
+// gettext source text.
+func (c *Catalog) Translate(lang, key string, args ...any) string {
+	m, _, ok := c.lookup(lang, key)
+	if !ok {
+		return format(key, args)
+	}
+	return format(m.text, args)
+}
+
+// TranslatePlural returns the form of the message with the given key that
+// corresponds to the given quantity in the given language, formatted with the
+// given arguments. The quantity can be an integer, a float or a decimal string.
+// Missing messages fall back as with Translate.
+func (c *Catalog) TranslatePlural(lang, key string, n any, args ...any) string {
+	m, lang, ok := c.lookup(lang, key)
+	if !ok {
+		return format(key, args)
+	}
+
+	switch {
+	case m.pluralIndex != nil:
+		o, _ := makeOperands(n)
+		if i := m.pluralIndex(o.i); i >= 0 && i < int64(len(m.forms)) {
+			return format(m.forms[i], args)
+		}
+
+	case m.plurals != nil:
+		if form, ok := m.plurals[Locale(lang).PluralCategory(n)]; ok {
+			return format(form, args)
+		}
+	}
+	return format(m.text, args)
+}
+
+// lookup returns the message with the given key and the language it was found
+// in.
+func (c *Catalog) lookup(lang, key string) (message, string, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for _, tags := range [][]string{parents(lang), parents(c.fallback)} {
+		for _, tag := range tags {
+			if m, ok := c.messages[tag][key]; ok {
+				return m, tag, true
+			}
+		}
+	}
+	return message{}, "", false
+}
+
+func format(text string, args []any) string {
+	if len(args) == 0 || !strings.Contains(text, "%") {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog(t *testing.T) {
+	c := NewCatalog("en")
+	c.Set("en", "hello", "Hello %s")
+	c.Set("en", "bye", "Bye")
+	c.Set("fr", "hello", "Bonjour %s")
+	c.SetPlural("en", "items", map[PluralCategory]string{
+		One:   "%d item",
+		Other: "%d items",
+	})
+	c.SetPlural("fr", "items", map[PluralCategory]string{
+		One:   "%d élément",
+		Other: "%d éléments",
+	})
+
+	t.Run("languages", func(t *testing.T) {
+		require.Equal(t, "en", c.Fallback())
+		require.Equal(t, []string{"en", "fr"}, c.Languages())
+	})
+
+	t.Run("translate", func(t *testing.T) {
+		require.Equal(t, "Hello Maxence", c.Translate("en", "hello", "Maxence"))
+		require.Equal(t, "Bonjour Maxence", c.Translate("fr", "hello", "Maxence"))
+	})
+
+	t.Run("translate with region falls back to base language", func(t *testing.T) {
+		require.Equal(t, "Bonjour Maxence", c.Translate("fr-CA", "hello", "Maxence"))
+	})
+
+	t.Run("missing message falls back to fallback language", func(t *testing.T) {
+		require.Equal(t, "Bye", c.Translate("fr", "bye"))
+	})
+
+	t.Run("missing message returns the key", func(t *testing.T) {
+		require.Equal(t, "unknown.key", c.Translate("fr", "unknown.key", 42))
+		require.Equal(t, "Welcome Maxence", c.Translate("fr", "Welcome %s", "Maxence"))
+	})
+
+	t.Run("translate plural", func(t *testing.T) {
+		require.Equal(t, "1 item", c.TranslatePlural("en", "items", 1, 1))
+		require.Equal(t, "0 items", c.TranslatePlural("en", "items", 0, 0))
+		require.Equal(t, "0 élément", c.TranslatePlural("fr", "items", 0, 0))
+		require.Equal(t, "2 éléments", c.TranslatePlural("fr", "items", 2, 2))
+	})
+
+	t.Run("translate plural uses the other form when a form is missing", func(t *testing.T) {
+		require.Equal(t, "1000000 éléments", c.TranslatePlural("fr", "items", 1000000, 1000000))
+	})
+
+	t.Run("translate plural of a singular message", func(t *testing.T) {
+		require.Equal(t, "Bye", c.TranslatePlural("en", "bye", 2))
+	})
+}
+
+func TestCatalogLoadJSON(t *testing.T) {
+	t.Run("messages are loaded", func(t *testing.T) {
+		c := NewCatalog("en")
+		err := c.LoadJSON("ru", []byte(`{
+			"hello": "Привет",
+			"cart": {
+				"title": "Корзина",
+				"items": {
+					"one": "%d товар",
+					"few": "%d товара",
+					"many": "%d товаров",
+					"other": "%d товара"
+				}
+			}
+		}`))
+		require.NoError(t, err)
+
+		require.Equal(t, "Привет", c.Translate("ru", "hello"))
+		require.Equal(t, "Корзина", c.Translate("ru", "cart.title"))
+		require.Equal(t, "21 товар", c.TranslatePlural("ru", "cart.items", 21, 21))
+		require.Equal(t, "3 товара", c.TranslatePlural("ru", "cart.items", 3, 3))
+		require.Equal(t, "5 товаров", c.TranslatePlural("ru", "cart.items", 5, 5))
+		require.Equal(t, "2 товара", c.TranslatePlural("ru", "cart.items", 1.5, 2))
+	})
+
+	t.Run("invalid json returns an error", func(t *testing.T) {
+		c := NewCatalog("en")
+		err := c.LoadJSON("fr", []byte(`{`))
+		require.Error(t, err)
+	})
+
+	t.Run("non string message returns an error", func(t *testing.T) {
+		c := NewCatalog("en")
+		err := c.LoadJSON("fr", []byte(`{"count": 42}`))
+		require.Error(t, err)
+	})
+}
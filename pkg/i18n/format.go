@@ -0,0 +1,351 @@
+package i18n
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Locale is a language tag that formats numbers, currencies and dates with the
+// conventions of its language and region. Tags without formatting data fall
+// back to their base language, then to English.
+type Locale string
+
+// DateStyle is the style of a formatted date.
+type DateStyle int
+
+const (
+	// ShortDate formats dates with digits only, such as "1/2/06".
+	ShortDate DateStyle = iota
+
+	// LongDate formats dates with the month name, such as "January 2, 2006".
+	LongDate
+)
+
+// The placeholder replaced by the month name in date layouts.
+const monthPlaceholder = "MMMM"
+
+// localeData describes the formatting conventions of a locale.
+type localeData struct {
+	// The decimal and grouping separators.
+	decimal string
+	group   string
+
+	// The minimum number of digits in the integer part of a number for it to
+	// be grouped.
+	minGrouping int
+
+	// The currency and percent patterns, where "¤" is the currency symbol,
+	// "%" the percent sign and "#" the number. Like the grouping separators,
+	// their spaces are non-breaking.
+	currency string
+	percent  string
+
+	// The Go time layouts of dates and times. Long dates can contain
+	// monthPlaceholder.
+	shortDate string
+	longDate  string
+	time      string
+
+	months []string
+
+	// The currency symbols that differ from the default ones.
+	symbols map[string]string
+}
+
+var (
+	englishMonths = []string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	}
+
+	locales = map[string]localeData{
+		"de": {
+			decimal:   ",",
+			group:     ".",
+			currency:  "#\u00a0¤",
+			percent:   "#\u00a0%",
+			shortDate: "02.01.06",
+			longDate:  "2. MMMM 2006",
+			time:      "15:04",
+			months: []string{
+				"Januar", "Februar", "März", "April", "Mai", "Juni",
+				"Juli", "August", "September", "Oktober", "November", "Dezember",
+			},
+		},
+		"en": {
+			decimal:   ".",
+			group:     ",",
+			currency:  "¤#",
+			percent:   "#%",
+			shortDate: "1/2/06",
+			longDate:  "MMMM 2, 2006",
+			time:      "3:04 PM",
+			months:    englishMonths,
+		},
+		"en-GB": {
+			decimal:   ".",
+			group:     ",",
+			currency:  "¤#",
+			percent:   "#%",
+			shortDate: "02/01/2006",
+			longDate:  "2 MMMM 2006",
+			time:      "15:04",
+			months:    englishMonths,
+			symbols:   map[string]string{"USD": "US$"},
+		},
+		"es": {
+			decimal:     ",",
+			group:       ".",
+			minGrouping: 5,
+			currency:    "#\u00a0¤",
+			percent:     "#\u00a0%",
+			shortDate:   "2/1/06",
+			longDate:    "2 de MMMM de 2006",
+			time:        "15:04",
+			months: []string{
+				"enero", "febrero", "marzo", "abril", "mayo", "junio",
+				"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+			},
+			symbols: map[string]string{"USD": "US$"},
+		},
+		"fr": {
+			decimal:   ",",
+			group:     "\u202f",
+			currency:  "#\u00a0¤",
+			percent:   "#\u202f%",
+			shortDate: "02/01/2006",
+			longDate:  "2 MMMM 2006",
+			time:      "15:04",
+			months: []string{
+				"janvier", "février", "mars", "avril", "mai", "juin",
+				"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+			},
+			symbols: map[string]string{"USD": "$US"},
+		},
+		"it": {
+			decimal:   ",",
+			group:     ".",
+			currency:  "#\u00a0¤",
+			percent:   "#%",
+			shortDate: "02/01/06",
+			longDate:  "2 MMMM 2006",
+			time:      "15:04",
+			months: []string{
+				"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno",
+				"luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre",
+			},
+			symbols: map[string]string{"USD": "USD"},
+		},
+		"ja": {
+			decimal:   ".",
+			group:     ",",
+			currency:  "¤#",
+			percent:   "#%",
+			shortDate: "2006/01/02",
+			longDate:  "2006年1月2日",
+			time:      "15:04",
+			symbols:   map[string]string{"JPY": "￥"},
+		},
+		"nl": {
+			decimal:   ",",
+			group:     ".",
+			currency:  "¤\u00a0#",
+			percent:   "#%",
+			shortDate: "02-01-2006",
+			longDate:  "2 MMMM 2006",
+			time:      "15:04",
+			months: []string{
+				"januari", "februari", "maart", "april", "mei", "juni",
+				"juli", "augustus", "september", "oktober", "november", "december",
+			},
+			symbols: map[string]string{"USD": "US$"},
+		},
+		"pt": {
+			decimal:   ",",
+			group:     ".",
+			currency:  "¤\u00a0#",
+			percent:   "#%",
+			shortDate: "02/01/2006",
+			longDate:  "2 de MMMM de 2006",
+			time:      "15:04",
+			months: []string{
+				"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+				"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+			},
+			symbols: map[string]string{"USD": "US$"},
+		},
+		"ru": {
+			decimal:   ",",
+			group:     "\u00a0",
+			currency:  "#\u00a0¤",
+			percent:   "#\u00a0%",
+			shortDate: "02.01.2006",
+			longDate:  "2 MMMM 2006 г.",
+			time:      "15:04",
+			months: []string{
+				"января", "февраля", "марта", "апреля", "мая", "июня",
+				"июля", "августа", "сентября", "октября", "ноября", "декабря",
+			},
+			symbols: map[string]string{"RUB": "₽", "USD": "$"},
+		},
+		"zh": {
+			decimal:   ".",
+			group:     ",",
+			currency:  "¤#",
+			percent:   "#%",
+			shortDate: "2006/1/2",
+			longDate:  "2006年1月2日",
+			time:      "15:04",
+			symbols:   map[string]string{"CNY": "¥", "USD": "US$"},
+		},
+	}
+
+	// The currency symbols used when a locale does not define its own.
+	currencySymbols = map[string]string{
+		"AUD": "A$",
+		"BRL": "R$",
+		"CAD": "CA$",
+		"CNY": "CN¥",
+		"EUR": "€",
+		"GBP": "£",
+		"INR": "₹",
+		"JPY": "¥",
+		"KRW": "₩",
+		"RUB": "RUB",
+		"USD": "$",
+	}
+
+	// The number of fraction digits of the currencies that do not use 2.
+	currencyDigits = map[string]int{
+		"JPY": 0,
+		"KRW": 0,
+	}
+)
+
+// Number returns the given number formatted with at most 3 fraction digits,
+// such as "1,234.568" in English or "1 234,568" in French.
+func (l Locale) Number(v float64) string {
+	return l.formatNumber(v, 3, false)
+}
+
+// Decimal returns the given number formatted with the given number of fraction
+// digits.
+func (l Locale) Decimal(v float64, digits int) string {
+	return l.formatNumber(v, digits, true)
+}
+
+// Percent returns the given ratio formatted as a percentage, such as "25%" for
+// 0.25 in English or "25 %" in French.
+func (l Locale) Percent(v float64) string {
+	d := l.data()
+	return strings.Replace(d.percent, "#", l.formatNumber(v*100, 0, true), 1)
+}
+
+// Currency returns the given amount of the currency with the given ISO 4217
+// code, such as "$1,234.50" for USD in English or "1 234,50 €" for EUR in
+// French.
+func (l Locale) Currency(v float64, code string) string {
+	d := l.data()
+
+	code = strings.ToUpper(code)
+	symbol, ok := d.symbols[code]
+	if !ok {
+		if symbol, ok = currencySymbols[code]; !ok {
+			symbol = code
+		}
+	}
+
+	digits, ok := currencyDigits[code]
+	if !ok {
+		digits = 2
+	}
+
+	number := l.formatNumber(math.Abs(v), digits, true)
+	s := strings.Replace(strings.Replace(d.currency, "#", number, 1), "¤", symbol, 1)
+	if v < 0 && number != l.formatNumber(0, digits, true) {
+		s = "-" + s
+	}
+	return s
+}
+
+// Date returns the given date formatted with the given style.
+func (l Locale) Date(t time.Time, style DateStyle) string {
+	d := l.data()
+	if style != LongDate {
+		return t.Format(d.shortDate)
+	}
+
+	s := t.Format(d.longDate)
+	if len(d.months) == 12 {
+		s = strings.Replace(s, monthPlaceholder, d.months[t.Month()-1], 1)
+	}
+	return s
+}
+
+// Time returns the hour and minutes of the given time, such as "3:04 PM" in
+// English or "15:04" in French.
+func (l Locale) Time(t time.Time) string {
+	return t.Format(l.data().time)
+}
+
+// PluralCategory returns the plural category of the given number, which can be
+// an integer, a float or a decimal string such as "1.50". It returns Other when
+// the number is not valid or when the language is not supported.
+func (l Locale) PluralCategory(n any) PluralCategory {
+	o, err := makeOperands(n)
+	if err != nil {
+		return Other
+	}
+
+	for _, tag := range parents(string(l)) {
+		if rule, ok := pluralRules[tag]; ok {
+			return rule(o)
+		}
+	}
+	return Other
+}
+
+func (l Locale) data() localeData {
+	for _, tag := range parents(string(l)) {
+		if d, ok := locales[tag]; ok {
+			return d
+		}
+	}
+	return locales["en"]
+}
+
+func (l Locale) formatNumber(v float64, digits int, fixed bool) string {
+	d := l.data()
+
+	s := strconv.FormatFloat(math.Abs(v), 'f', digits, 64)
+	integer, fraction, _ := strings.Cut(s, ".")
+	if !fixed {
+		fraction = strings.TrimRight(fraction, "0")
+	}
+
+	minGrouping := d.minGrouping
+	if minGrouping == 0 {
+		minGrouping = 4
+	}
+	if len(integer) >= minGrouping {
+		var b strings.Builder
+		for i, c := range integer {
+			if i != 0 && (len(integer)-i)%3 == 0 {
+				b.WriteString(d.group)
+			}
+			b.WriteRune(c)
+		}
+		integer = b.String()
+	}
+
+	s = integer
+	if fraction != "" {
+		s += d.decimal + fraction
+	}
+	if v < 0 && strings.Trim(s, "0"+d.decimal+d.group) != "" {
+		s = "-" + s
+	}
+	return s
+}
@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocaleNumber(t *testing.T) {
+	require.Equal(t, "1,234.568", Locale("en").Number(1234.5678))
+	require.Equal(t, "-1,234,567", Locale("en-US").Number(-1234567))
+	require.Equal(t, "0.5", Locale("en").Number(0.5))
+	require.Equal(t, "0", Locale("en").Number(-0.0001))
+	require.Equal(t, "1\u202f234,5", Locale("fr").Number(1234.5))
+	require.Equal(t, "1.234,5", Locale("de").Number(1234.5))
+	require.Equal(t, "1234", Locale("es").Number(1234))
+	require.Equal(t, "12.345", Locale("es").Number(12345))
+	require.Equal(t, "1,234.5", Locale("xx").Number(1234.5))
+}
+
+func TestLocaleDecimal(t *testing.T) {
+	require.Equal(t, "1,234.50", Locale("en").Decimal(1234.5, 2))
+	require.Equal(t, "2", Locale("en").Decimal(1.5, 0))
+}
+
+func TestLocalePercent(t *testing.T) {
+	require.Equal(t, "25%", Locale("en").Percent(0.25))
+	require.Equal(t, "25\u202f%", Locale("fr").Percent(0.25))
+	require.Equal(t, "12\u00a0%", Locale("de").Percent(0.123))
+}
+
+func TestLocaleCurrency(t *testing.T) {
+	require.Equal(t, "$1,234.50", Locale("en").Currency(1234.5, "USD"))
+	require.Equal(t, "-$3.00", Locale("en").Currency(-3, "usd"))
+	require.Equal(t, "1\u202f234,50\u00a0€", Locale("fr-FR").Currency(1234.5, "EUR"))
+	require.Equal(t, "R$\u00a01.234,50", Locale("pt-BR").Currency(1234.5, "BRL"))
+	require.Equal(t, "￥1,235", Locale("ja").Currency(1234.56, "JPY"))
+	require.Equal(t, "¥1,234", Locale("en").Currency(1234.5, "JPY"))
+	require.Equal(t, "CHF42.00", Locale("en").Currency(42, "CHF"))
+}
+
+func TestLocaleDate(t *testing.T) {
+	date := time.Date(2024, time.March, 7, 15, 4, 0, 0, time.UTC)
+
+	utests := []struct {
+		locale Locale
+		short  string
+		long   string
+		time   string
+	}{
+		{locale: "en", short: "3/7/24", long: "March 7, 2024", time: "3:04 PM"},
+		{locale: "en-GB", short: "07/03/2024", long: "7 March 2024", time: "15:04"},
+		{locale: "fr", short: "07/03/2024", long: "7 mars 2024", time: "15:04"},
+		{locale: "de-AT", short: "07.03.24", long: "7. März 2024", time: "15:04"},
+		{locale: "es", short: "7/3/24", long: "7 de marzo de 2024", time: "15:04"},
+		{locale: "ru", short: "07.03.2024", long: "7 марта 2024 г.", time: "15:04"},
+		{locale: "ja", short: "2024/03/07", long: "2024年3月7日", time: "15:04"},
+	}
+
+	for _, u := range utests {
+		t.Run(string(u.locale), func(t *testing.T) {
+			require.Equal(t, u.short, u.locale.Date(date, ShortDate))
+			require.Equal(t, u.long, u.locale.Date(date, LongDate))
+			require.Equal(t, u.time, u.locale.Time(date))
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Package i18n provides message catalogs, plural rules and locale aware
+// formatting to internationalize go-app applications.
+//
+// Messages are loaded into a Catalog from JSON or gettext PO files, then
+// translated for a given language:
+//
+//	catalog := i18n.NewCatalog("en")
+//	catalog.LoadJSON("fr", []byte(`{
+//	    "hello": "Bonjour %s",
+//	    "items": {"one": "%d élément", "other": "%d éléments"}
+//	}`))
+//
+//	catalog.Translate("fr", "hello", "Maxence")  // Bonjour Maxence
+//	catalog.TranslatePlural("fr", "items", 2, 2) // 2 éléments
+//
+// Numbers, currencies and dates are formatted with a Locale:
+//
+//	i18n.Locale("fr").Currency(1234.5, "EUR") // 1 234,50 €
+//
+// Languages are identified by BCP 47 tags, such as "en", "en-US" or "pt-BR".
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseAcceptLanguage returns the language tags listed in the given
+// Accept-Language header value, ordered by decreasing quality. Tags with a
+// zero quality and the "*" wildcard are omitted.
+func ParseAcceptLanguage(v string) []string {
+	type language struct {
+		tag     string
+		quality float64
+	}
+
+	var languages []language
+	for _, item := range strings.Split(v, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(item), ";")
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			f, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = f
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		languages = append(languages, language{
+			tag:     Canonical(tag),
+			quality: quality,
+		})
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+
+	tags := make([]string, len(languages))
+	for i, l := range languages {
+		tags[i] = l.tag
+	}
+	return tags
+}
+
+// Negotiate returns the supported language that best matches the given
+// preferred languages, which are ordered by decreasing preference. It returns
+// the given fallback when none of them matches.
+func Negotiate(preferred, supported []string, fallback string) string {
+	for _, tag := range preferred {
+		if match, ok := Match(tag, supported); ok {
+			return match
+		}
+	}
+	return fallback
+}
+
+// Match returns the supported language that matches the given language tag.
+// An exact match is preferred, then a supported language with the same base
+// language, such as "pt" or "pt-BR" for "pt-PT".
+func Match(tag string, supported []string) (string, bool) {
+	tag = Canonical(tag)
+	if tag == "" {
+		return "", false
+	}
+
+	for _, s := range supported {
+		if Canonical(s) == tag {
+			return s, true
+		}
+	}
+
+	base := Base(tag)
+	for _, s := range supported {
+		if Canonical(s) == base {
+			return s, true
+		}
+	}
+	for _, s := range supported {
+		if Base(s) == base {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// Canonical returns the given language tag with a lowercase language and an
+// uppercase region, such as "en-US". Underscores are replaced by hyphens.
+func Canonical(tag string) string {
+	parts := strings.Split(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	for i, p := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(p)
+
+		case len(p) == 2:
+			parts[i] = strings.ToUpper(p)
+
+		case len(p) == 4:
+			parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+
+		default:
+			parts[i] = strings.ToLower(p)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// Base returns the language part of the given language tag, such as "en" for
+// "en-US".
+func Base(tag string) string {
+	base, _, _ := strings.Cut(Canonical(tag), "-")
+	return base
+}
+
+// parents returns the given language tag followed by the tags it falls back
+// to, from the most to the least specific: "zh-Hant-TW", "zh-Hant", "zh".
+func parents(tag string) []string {
+	tag = Canonical(tag)
+	tags := []string{tag}
+	for {
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			return tags
+		}
+		tag = tag[:i]
+		tags = append(tags, tag)
+	}
+}
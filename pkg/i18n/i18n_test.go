@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	utests := []struct {
+		scenario string
+		header   string
+		expected []string
+	}{
+		{
+			scenario: "empty header",
+			expected: []string{},
+		},
+		{
+			scenario: "single language",
+			header:   "fr",
+			expected: []string{"fr"},
+		},
+		{
+			scenario: "languages are sorted by quality",
+			header:   "en;q=0.5, fr-ca, fr;q=0.8",
+			expected: []string{"fr-CA", "fr", "en"},
+		},
+		{
+			scenario: "wildcard, zero quality and invalid quality are ignored",
+			header:   "*, de;q=0, es;q=abc, it",
+			expected: []string{"it"},
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			require.Equal(t, u.expected, ParseAcceptLanguage(u.header))
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	supported := []string{"en", "fr", "pt-BR", "zh-Hant"}
+
+	utests := []struct {
+		scenario  string
+		preferred []string
+		expected  string
+	}{
+		{
+			scenario:  "exact match",
+			preferred: []string{"fr"},
+			expected:  "fr",
+		},
+		{
+			scenario:  "region falls back to base language",
+			preferred: []string{"fr-CA"},
+			expected:  "fr",
+		},
+		{
+			scenario:  "base language matches a region",
+			preferred: []string{"pt-PT"},
+			expected:  "pt-BR",
+		},
+		{
+			scenario:  "case is ignored",
+			preferred: []string{"zh-hant"},
+			expected:  "zh-Hant",
+		},
+		{
+			scenario:  "first match is preferred",
+			preferred: []string{"de", "pt", "fr"},
+			expected:  "pt-BR",
+		},
+		{
+			scenario:  "fallback",
+			preferred: []string{"de", "ja"},
+			expected:  "en",
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			require.Equal(t, u.expected, Negotiate(u.preferred, supported, "en"))
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	require.Equal(t, "en-US", Canonical("EN_us"))
+	require.Equal(t, "zh-Hant-TW", Canonical("zh-hant-tw"))
+	require.Equal(t, "es-419", Canonical("es-419"))
+	require.Equal(t, "zh", Base("zh-Hant-TW"))
+	require.Equal(t, []string{"zh-Hant-TW", "zh-Hant", "zh"}, parents("zh-hant-tw"))
+}
@@ -0,0 +1,283 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// PluralCategory is a CLDR plural category, which selects the form of a
+// message that depends on a quantity.
+type PluralCategory string
+
+// The CLDR plural categories. Every language uses Other, while the use of the
+// other categories depends on the language.
+const (
+	Zero  PluralCategory = "zero"
+	One   PluralCategory = "one"
+	Two   PluralCategory = "two"
+	Few   PluralCategory = "few"
+	Many  PluralCategory = "many"
+	Other PluralCategory = "other"
+)
+
+// operands are the CLDR plural operands of a number.
+type operands struct {
+	n float64 // Absolute value.
+	i int64   // Integer digits.
+	v int     // Number of visible fraction digits, with trailing zeros.
+	f int64   // Visible fraction digits, with trailing zeros.
+	t int64   // Visible fraction digits, without trailing zeros.
+}
+
+// makeOperands returns the plural operands of the given number, which can be
+// an integer, a float or a decimal string. Decimal strings keep their trailing
+// zeros, which matter in some languages: "1" and "1.0" do not always have the
+// same plural category.
+func makeOperands(n any) (operands, error) {
+	var s string
+	switch n := n.(type) {
+	case int:
+		s = strconv.FormatInt(int64(n), 10)
+	case int8:
+		s = strconv.FormatInt(int64(n), 10)
+	case int16:
+		s = strconv.FormatInt(int64(n), 10)
+	case int32:
+		s = strconv.FormatInt(int64(n), 10)
+	case int64:
+		s = strconv.FormatInt(n, 10)
+	case uint:
+		s = strconv.FormatUint(uint64(n), 10)
+	case uint8:
+		s = strconv.FormatUint(uint64(n), 10)
+	case uint16:
+		s = strconv.FormatUint(uint64(n), 10)
+	case uint32:
+		s = strconv.FormatUint(uint64(n), 10)
+	case uint64:
+		s = strconv.FormatUint(n, 10)
+	case float32:
+		s = strconv.FormatFloat(float64(n), 'f', -1, 32)
+	case float64:
+		s = strconv.FormatFloat(n, 'f', -1, 64)
+	case string:
+		s = strings.TrimSpace(n)
+	default:
+		return operands{}, fmt.Errorf("%T is not a number", n)
+	}
+
+	s = strings.TrimPrefix(s, "-")
+	integer, fraction, _ := strings.Cut(s, ".")
+
+	var o operands
+	var err error
+	if o.n, err = strconv.ParseFloat(s, 64); err != nil {
+		return operands{}, err
+	}
+	if o.i, err = strconv.ParseInt(integer, 10, 64); err != nil {
+		return operands{}, err
+	}
+	if fraction != "" {
+		o.v = len(fraction)
+		if o.f, err = strconv.ParseInt(fraction, 10, 64); err != nil {
+			return operands{}, err
+		}
+		if trimmed := strings.TrimRight(fraction, "0"); trimmed != "" {
+			o.t, _ = strconv.ParseInt(trimmed, 10, 64)
+		}
+	}
+	return o, nil
+}
+
+// pluralRule returns the plural category of a number.
+type pluralRule func(o operands) PluralCategory
+
+// pluralRules are the CLDR cardinal plural rules, indexed by language. Rules
+// that only apply to numbers in compact notation are not included.
+var pluralRules = map[string]pluralRule{
+	"ar":    pluralArabic,
+	"bn":    pluralHindi,
+	"ca":    pluralItalian,
+	"cs":    pluralCzech,
+	"da":    pluralDanish,
+	"de":    pluralEnglish,
+	"el":    pluralOne,
+	"en":    pluralEnglish,
+	"es":    pluralSpanish,
+	"et":    pluralEnglish,
+	"fi":    pluralEnglish,
+	"fr":    pluralFrench,
+	"he":    pluralHebrew,
+	"hi":    pluralHindi,
+	"hu":    pluralOne,
+	"id":    pluralOther,
+	"it":    pluralItalian,
+	"ja":    pluralOther,
+	"ko":    pluralOther,
+	"ms":    pluralOther,
+	"nb":    pluralOne,
+	"nl":    pluralEnglish,
+	"no":    pluralOne,
+	"pl":    pluralPolish,
+	"pt":    pluralFrench,
+	"pt-PT": pluralItalian,
+	"ru":    pluralRussian,
+	"sk":    pluralCzech,
+	"sv":    pluralEnglish,
+	"th":    pluralOther,
+	"tr":    pluralOne,
+	"uk":    pluralRussian,
+	"vi":    pluralOther,
+	"zh":    pluralOther,
+}
+
+func pluralOther(o operands) PluralCategory {
+	return Other
+}
+
+func pluralOne(o operands) PluralCategory {
+	if o.n == 1 {
+		return One
+	}
+	return Other
+}
+
+func pluralEnglish(o operands) PluralCategory {
+	if o.i == 1 && o.v == 0 {
+		return One
+	}
+	return Other
+}
+
+func pluralDanish(o operands) PluralCategory {
+	if o.n == 1 || o.t != 0 && (o.i == 0 || o.i == 1) {
+		return One
+	}
+	return Other
+}
+
+func pluralHindi(o operands) PluralCategory {
+	if o.i == 0 || o.n == 1 {
+		return One
+	}
+	return Other
+}
+
+func pluralMillions(o operands) bool {
+	return o.i != 0 && o.i%1000000 == 0 && o.v == 0
+}
+
+func pluralSpanish(o operands) PluralCategory {
+	switch {
+	case o.n == 1:
+		return One
+	case pluralMillions(o):
+		return Many
+	default:
+		return Other
+	}
+}
+
+func pluralFrench(o operands) PluralCategory {
+	switch {
+	case o.i == 0 || o.i == 1:
+		return One
+	case pluralMillions(o):
+		return Many
+	default:
+		return Other
+	}
+}
+
+func pluralItalian(o operands) PluralCategory {
+	switch {
+	case o.i == 1 && o.v == 0:
+		return One
+	case pluralMillions(o):
+		return Many
+	default:
+		return Other
+	}
+}
+
+func pluralRussian(o operands) PluralCategory {
+	if o.v != 0 {
+		return Other
+	}
+
+	mod10 := o.i % 10
+	mod100 := o.i % 100
+	switch {
+	case mod10 == 1 && mod100 != 11:
+		return One
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return Few
+	default:
+		return Many
+	}
+}
+
+func pluralPolish(o operands) PluralCategory {
+	if o.v != 0 {
+		return Other
+	}
+
+	mod10 := o.i % 10
+	mod100 := o.i % 100
+	switch {
+	case o.i == 1:
+		return One
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return Few
+	default:
+		return Many
+	}
+}
+
+func pluralCzech(o operands) PluralCategory {
+	switch {
+	case o.v != 0:
+		return Many
+	case o.i == 1:
+		return One
+	case o.i >= 2 && o.i <= 4:
+		return Few
+	default:
+		return Other
+	}
+}
+
+func pluralHebrew(o operands) PluralCategory {
+	switch {
+	case o.i == 1 && o.v == 0 || o.i == 0 && o.v != 0:
+		return One
+	case o.i == 2 && o.v == 0:
+		return Two
+	default:
+		return Other
+	}
+}
+
+func pluralArabic(o operands) PluralCategory {
+	if o.n != math.Trunc(o.n) {
+		return Other
+	}
+
+	mod100 := math.Mod(o.n, 100)
+	switch {
+	case o.n == 0:
+		return Zero
+	case o.n == 1:
+		return One
+	case o.n == 2:
+		return Two
+	case mod100 >= 3 && mod100 <= 10:
+		return Few
+	case mod100 >= 11:
+		return Many
+	default:
+		return Other
+	}
+}
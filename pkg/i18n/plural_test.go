@@ -0,0 +1,70 @@
+package i18n
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluralCategory(t *testing.T) {
+	utests := []struct {
+		lang     string
+		n        any
+		expected PluralCategory
+	}{
+		{lang: "en", n: 1, expected: One},
+		{lang: "en", n: 0, expected: Other},
+		{lang: "en", n: 2, expected: Other},
+		{lang: "en", n: "1.0", expected: Other},
+		{lang: "en", n: -1, expected: One},
+		{lang: "en-GB", n: 1, expected: One},
+		{lang: "fr", n: 0, expected: One},
+		{lang: "fr", n: 1.5, expected: One},
+		{lang: "fr", n: 2, expected: Other},
+		{lang: "fr", n: 1000000, expected: Many},
+		{lang: "es", n: 1, expected: One},
+		{lang: "es", n: 0, expected: Other},
+		{lang: "pt-PT", n: 0, expected: Other},
+		{lang: "pt-BR", n: 0, expected: One},
+		{lang: "ru", n: 1, expected: One},
+		{lang: "ru", n: 21, expected: One},
+		{lang: "ru", n: 11, expected: Many},
+		{lang: "ru", n: 3, expected: Few},
+		{lang: "ru", n: 13, expected: Many},
+		{lang: "ru", n: 5, expected: Many},
+		{lang: "ru", n: 1.5, expected: Other},
+		{lang: "pl", n: 1, expected: One},
+		{lang: "pl", n: 22, expected: Few},
+		{lang: "pl", n: 21, expected: Many},
+		{lang: "cs", n: 3, expected: Few},
+		{lang: "cs", n: "1.5", expected: Many},
+		{lang: "ar", n: 0, expected: Zero},
+		{lang: "ar", n: 2, expected: Two},
+		{lang: "ar", n: 105, expected: Few},
+		{lang: "ar", n: 111, expected: Many},
+		{lang: "ar", n: 100, expected: Other},
+		{lang: "he", n: 2, expected: Two},
+		{lang: "da", n: "0.5", expected: One},
+		{lang: "ja", n: 1, expected: Other},
+		{lang: "xx", n: 1, expected: Other},
+		{lang: "en", n: "one", expected: Other},
+		{lang: "en", n: struct{}{}, expected: Other},
+	}
+
+	for _, u := range utests {
+		t.Run(fmt.Sprintf("%s %v", u.lang, u.n), func(t *testing.T) {
+			require.Equal(t, u.expected, Locale(u.lang).PluralCategory(u.n))
+		})
+	}
+}
+
+func TestMakeOperands(t *testing.T) {
+	o, err := makeOperands("-1.250")
+	require.NoError(t, err)
+	require.Equal(t, operands{n: 1.25, i: 1, v: 3, f: 250, t: 25}, o)
+
+	o, err = makeOperands(uint8(42))
+	require.NoError(t, err)
+	require.Equal(t, operands{n: 42, i: 42}, o)
+}
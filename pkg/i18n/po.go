@@ -0,0 +1,427 @@
+package i18n
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/errors"
+)
+
+// LoadPO loads the messages of the given language from the given gettext PO
+// file. Messages are keyed by their source text (msgid). The forms of plural
+// messages are selected with the plural expression of the Plural-Forms header,
+// and default to the English one when the header is missing.
+//
+// Fuzzy and untranslated entries are skipped. Message contexts (msgctxt) are
+// not supported: entries that have one are skipped.
+func (c *Catalog) LoadPO(lang string, data []byte) error {
+	entries, err := parsePO(data)
+	if err != nil {
+		return errors.New("parsing po file failed").
+			WithTag("lang", lang).
+			Wrap(err)
+	}
+
+	pluralIndex := func(n int64) int64 {
+		if n == 1 {
+			return 0
+		}
+		return 1
+	}
+
+	for _, e := range entries {
+		if e.id != "" || e.context || len(e.str) == 0 {
+			continue
+		}
+
+		for _, line := range strings.Split(e.str[0], "\n") {
+			name, value, _ := strings.Cut(line, ":")
+			if !strings.EqualFold(strings.TrimSpace(name), "Plural-Forms") {
+				continue
+			}
+
+			if pluralIndex, err = parsePluralForms(value); err != nil {
+				return errors.New("parsing po plural forms failed").
+					WithTag("lang", lang).
+					WithTag("plural-forms", strings.TrimSpace(value)).
+					Wrap(err)
+			}
+		}
+	}
+
+	for _, e := range entries {
+		if e.id == "" || e.fuzzy || e.context || !e.translated() {
+			continue
+		}
+
+		if e.idPlural == "" {
+			c.set(lang, e.id, message{text: e.str[0]})
+			continue
+		}
+
+		c.set(lang, e.id, message{
+			text:        e.str[len(e.str)-1],
+			forms:       e.str,
+			pluralIndex: pluralIndex,
+		})
+	}
+	return nil
+}
+
+// poEntry is an entry of a PO file.
+type poEntry struct {
+	id       string
+	idPlural string
+	str      []string
+	fuzzy    bool
+	context  bool
+}
+
+func (e poEntry) translated() bool {
+	for _, s := range e.str {
+		if s == "" {
+			return false
+		}
+	}
+	return len(e.str) != 0
+}
+
+func parsePO(data []byte) ([]poEntry, error) {
+	var entries []poEntry
+	var entry poEntry
+	var field *string
+	started := false
+
+	flush := func() {
+		if started {
+			entries = append(entries, entry)
+		}
+		entry = poEntry{}
+		field = nil
+		started = false
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			flush()
+			continue
+
+		case strings.HasPrefix(line, "#"):
+			if started {
+				flush()
+			}
+			if strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy") {
+				entry.fuzzy = true
+			}
+			continue
+
+		case strings.HasPrefix(line, `"`):
+			if field == nil {
+				return nil, errors.New("unexpected string").
+					WithTag("line", lineNumber)
+			}
+			s, err := strconv.Unquote(line)
+			if err != nil {
+				return nil, errors.New("invalid string").
+					WithTag("line", lineNumber).
+					Wrap(err)
+			}
+			*field += s
+			continue
+		}
+
+		keyword, value, _ := strings.Cut(line, " ")
+		s, err := strconv.Unquote(strings.TrimSpace(value))
+		if err != nil {
+			return nil, errors.New("invalid string").
+				WithTag("line", lineNumber).
+				WithTag("keyword", keyword).
+				Wrap(err)
+		}
+
+		switch {
+		case keyword == "msgctxt":
+			if entry.id != "" || len(entry.str) != 0 {
+				flush()
+			}
+			entry.context = true
+			field = new(string)
+
+		case keyword == "msgid":
+			if len(entry.str) != 0 {
+				flush()
+			}
+			entry.id = s
+			field = &entry.id
+
+		case keyword == "msgid_plural":
+			entry.idPlural = s
+			field = &entry.idPlural
+
+		case keyword == "msgstr":
+			entry.str = append(entry.str, s)
+			field = &entry.str[len(entry.str)-1]
+
+		case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+			index, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+			if err != nil || index != len(entry.str) {
+				return nil, errors.New("invalid plural form index").
+					WithTag("line", lineNumber).
+					WithTag("keyword", keyword)
+			}
+			entry.str = append(entry.str, s)
+			field = &entry.str[index]
+
+		default:
+			return nil, errors.New("unknown keyword").
+				WithTag("line", lineNumber).
+				WithTag("keyword", keyword)
+		}
+		started = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	flush()
+	return entries, nil
+}
+
+// parsePluralForms returns the function that computes the plural form index
+// described by the given Plural-Forms header value, such as
+// "nplurals=2; plural=(n != 1);".
+func parsePluralForms(v string) (func(n int64) int64, error) {
+	for _, part := range strings.Split(v, ";") {
+		name, expr, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if strings.TrimSpace(name) != "plural" {
+			continue
+		}
+
+		p := pluralParser{input: expr}
+		eval, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+		return eval, nil
+	}
+	return nil, errors.New("plural expression not found")
+}
+
+// pluralParser parses the C expression that computes the plural form index of
+// a number n in the Plural-Forms header of a PO file.
+type pluralParser struct {
+	input string
+	pos   int
+}
+
+type pluralExpr func(n int64) int64
+
+func (p *pluralParser) parse() (pluralExpr, error) {
+	expr, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos != len(p.input) {
+		return nil, p.errorf("unexpected character")
+	}
+	return expr, nil
+}
+
+func (p *pluralParser) parseTernary() (pluralExpr, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume("?") {
+		return cond, nil
+	}
+
+	yes, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume(":") {
+		return nil, p.errorf("missing ':'")
+	}
+	no, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(n int64) int64 {
+		if cond(n) != 0 {
+			return yes(n)
+		}
+		return no(n)
+	}, nil
+}
+
+// pluralOperators are the binary operators, ordered by increasing precedence.
+// Longer operators are listed before the ones they start with.
+var pluralOperators = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<=", ">=", "<", ">"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *pluralParser) parseBinary(level int) (pluralExpr, error) {
+	if level == len(pluralOperators) {
+		return p.parseUnary()
+	}
+
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var op string
+		for _, o := range pluralOperators[level] {
+			if p.consume(o) {
+				op = o
+				break
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = pluralBinary(op, left, right)
+	}
+}
+
+func pluralBinary(op string, a, b pluralExpr) pluralExpr {
+	boolean := func(v bool) int64 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	return func(n int64) int64 {
+		x := a(n)
+		switch op {
+		case "||":
+			return boolean(x != 0 || b(n) != 0)
+		case "&&":
+			return boolean(x != 0 && b(n) != 0)
+		}
+
+		y := b(n)
+		switch op {
+		case "==":
+			return boolean(x == y)
+		case "!=":
+			return boolean(x != y)
+		case "<=":
+			return boolean(x <= y)
+		case ">=":
+			return boolean(x >= y)
+		case "<":
+			return boolean(x < y)
+		case ">":
+			return boolean(x > y)
+		case "+":
+			return x + y
+		case "-":
+			return x - y
+		case "*":
+			return x * y
+		case "/":
+			if y == 0 {
+				return 0
+			}
+			return x / y
+		default:
+			if y == 0 {
+				return 0
+			}
+			return x % y
+		}
+	}
+}
+
+func (p *pluralParser) parseUnary() (pluralExpr, error) {
+	if p.consume("!") {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n int64) int64 {
+			if expr(n) == 0 {
+				return 1
+			}
+			return 0
+		}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *pluralParser) parsePrimary() (pluralExpr, error) {
+	p.skipSpaces()
+
+	switch {
+	case p.consume("("):
+		expr, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf("missing ')'")
+		}
+		return expr, nil
+
+	case p.consume("n"):
+		return func(n int64) int64 { return n }, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, p.errorf("unexpected character")
+	}
+
+	v, err := strconv.ParseInt(p.input[start:p.pos], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return func(int64) int64 { return v }, nil
+}
+
+func (p *pluralParser) consume(s string) bool {
+	p.skipSpaces()
+	if !strings.HasPrefix(p.input[p.pos:], s) {
+		return false
+	}
+	p.pos += len(s)
+	return true
+}
+
+func (p *pluralParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\r\n", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *pluralParser) errorf(msg string) error {
+	return errors.New(msg).
+		WithTag("expression", strings.TrimSpace(p.input)).
+		WithTag("position", p.pos)
+}
@@ -0,0 +1,118 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogLoadPO(t *testing.T) {
+	t.Run("messages are loaded", func(t *testing.T) {
+		c := NewCatalog("en")
+		err := c.LoadPO("pl", []byte(`
+# Polish translations.
+msgid ""
+msgstr ""
+"Language: pl\n"
+"Plural-Forms: nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && "
+"(n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+#: main.go:12
+msgid "Hello %s"
+msgstr "Cześć %s"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d plik"
+msgstr[1] "%d pliki"
+msgstr[2] "%d plików"
+
+#, fuzzy
+msgid "Save"
+msgstr "Zapisz"
+
+msgid "Cancel"
+msgstr ""
+
+msgctxt "menu"
+msgid "Open"
+msgstr "Otwórz"
+`))
+		require.NoError(t, err)
+
+		require.Equal(t, "Cześć Maxence", c.Translate("pl", "Hello %s", "Maxence"))
+		require.Equal(t, "1 plik", c.TranslatePlural("pl", "%d file", 1, 1))
+		require.Equal(t, "3 pliki", c.TranslatePlural("pl", "%d file", 3, 3))
+		require.Equal(t, "5 plików", c.TranslatePlural("pl", "%d file", 5, 5))
+		require.Equal(t, "12 plików", c.TranslatePlural("pl", "%d file", 12, 12))
+		require.Equal(t, "22 pliki", c.TranslatePlural("pl", "%d file", 22, 22))
+		require.Equal(t, "Save", c.Translate("pl", "Save"))
+		require.Equal(t, "Cancel", c.Translate("pl", "Cancel"))
+		require.Equal(t, "Open", c.Translate("pl", "Open"))
+	})
+
+	t.Run("plural forms default to english", func(t *testing.T) {
+		c := NewCatalog("en")
+		err := c.LoadPO("de", []byte(`
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d Datei"
+msgstr[1] "%d Dateien"
+`))
+		require.NoError(t, err)
+		require.Equal(t, "1 Datei", c.TranslatePlural("de", "%d file", 1, 1))
+		require.Equal(t, "0 Dateien", c.TranslatePlural("de", "%d file", 0, 0))
+	})
+
+	t.Run("invalid po returns an error", func(t *testing.T) {
+		files := []string{
+			`msgid "hello`,
+			`"hello"`,
+			`msgfoo "hello"`,
+			"msgid \"a\"\nmsgid_plural \"b\"\nmsgstr[1] \"c\"",
+			"msgid \"\"\nmsgstr \"Plural-Forms: nplurals=2; plural=(n != 1;\\n\"",
+		}
+
+		for _, f := range files {
+			c := NewCatalog("en")
+			require.Error(t, c.LoadPO("fr", []byte(f)), f)
+		}
+	})
+}
+
+func TestParsePluralForms(t *testing.T) {
+	utests := []struct {
+		expr     string
+		n        int64
+		expected int64
+	}{
+		{expr: "nplurals=1; plural=0;", n: 5, expected: 0},
+		{expr: "nplurals=2; plural=n != 1;", n: 1, expected: 0},
+		{expr: "nplurals=2; plural=n > 1;", n: 0, expected: 0},
+		{expr: "nplurals=2; plural=(n > 1);", n: 2, expected: 1},
+		{expr: "nplurals=3; plural=n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2;", n: 24, expected: 1},
+		{expr: "nplurals=6; plural=n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 ? 4 : 5;", n: 102, expected: 5},
+		{expr: "nplurals=2; plural=!(n == 1);", n: 1, expected: 0},
+		{expr: "nplurals=2; plural=(n - 1) * 2 / 2 + 0;", n: 3, expected: 2},
+	}
+
+	for _, u := range utests {
+		t.Run(u.expr, func(t *testing.T) {
+			eval, err := parsePluralForms(u.expr)
+			require.NoError(t, err)
+			require.Equal(t, u.expected, eval(u.n))
+		})
+	}
+
+	t.Run("missing plural expression returns an error", func(t *testing.T) {
+		_, err := parsePluralForms("nplurals=2;")
+		require.Error(t, err)
+	})
+
+	t.Run("invalid expression returns an error", func(t *testing.T) {
+		for _, expr := range []string{"plural=n ?", "plural=n ? 1", "plural=(n", "plural=x", "plural=n n"} {
+			_, err := parsePluralForms(expr)
+			require.Error(t, err, expr)
+		}
+	})
+}
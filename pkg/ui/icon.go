@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/maxence-charriere/go-app/v9/pkg/app"
+	"github.com/maxence-charriere/go-app/v9/pkg/css"
 )
 
 // IIcon is the interface that describes an icon.
@@ -81,21 +82,26 @@ func (i *icon) Render() app.UI {
 		content = app.Raw(fmt.Sprintf(i.Isrc, i.Isize, i.Isize))
 	} else {
 		content = app.Img().
-			Style("max-width", "100%").
-			Style("max-height", "100%").
+			Styles(css.Styles().
+				MaxWidth(css.Percent(100)).
+				MaxHeight(css.Percent(100)),
+			).
 			Src(i.Isrc)
 	}
 
+	size := css.Px(float64(i.Isize))
 	icon := app.Div().
 		DataSet("goapp", "Icon").
 		ID(i.Iid).
 		Class(i.Iclass).
-		Style("width", pxToString(i.Isize)).
-		Style("height", pxToString(i.Isize)).
-		Style("max-width", pxToString(i.Isize)).
-		Style("max-height", pxToString(i.Isize)).
-		Style("min-width", pxToString(i.Isize)).
-		Style("min-height", pxToString(i.Isize)).
+		Styles(css.Styles().
+			Width(size).
+			Height(size).
+			MaxWidth(size).
+			MaxHeight(size).
+			MinWidth(size).
+			MinHeight(size),
+		).
 		Body(content)
 	for _, s := range i.Istyles {
 		icon.Style(s.key, s.value)
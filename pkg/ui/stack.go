@@ -1,6 +1,9 @@
 package ui
 
-import "github.com/maxence-charriere/go-app/v9/pkg/app"
+import (
+	"github.com/maxence-charriere/go-app/v9/pkg/app"
+	"github.com/maxence-charriere/go-app/v9/pkg/css"
+)
 
 // IStack is the interface that describes a container that displays its items
 // as stacked panels.
@@ -44,8 +47,8 @@ type IStack interface {
 // Stack creates a container that displays its items as stacked panels.
 func Stack() IStack {
 	return &stack{
-		IhorizontalAlign: "flex-start",
-		IverticalAlign:   "flex-start",
+		IhorizontalAlign: css.JustifyContentFlexStart,
+		IverticalAlign:   css.AlignItemsFlexStart,
 	}
 }
 
@@ -54,8 +57,8 @@ type stack struct {
 
 	Iid              string
 	Iclass           string
-	IhorizontalAlign string
-	IverticalAlign   string
+	IhorizontalAlign css.JustifyContent
+	IverticalAlign   css.AlignItems
 	Istyles          []style
 	Icontent         []app.UI
 }
@@ -82,37 +85,37 @@ func (s *stack) Style(k, v string) IStack {
 }
 
 func (s *stack) Left() IStack {
-	s.IhorizontalAlign = "flex-start"
+	s.IhorizontalAlign = css.JustifyContentFlexStart
 	return s
 }
 
 func (s *stack) Center() IStack {
-	s.IhorizontalAlign = "center"
+	s.IhorizontalAlign = css.JustifyContentCenter
 	return s
 }
 
 func (s *stack) Right() IStack {
-	s.IhorizontalAlign = "flex-end"
+	s.IhorizontalAlign = css.JustifyContentFlexEnd
 	return s
 }
 
 func (s *stack) Top() IStack {
-	s.IverticalAlign = "flex-start"
+	s.IverticalAlign = css.AlignItemsFlexStart
 	return s
 }
 
 func (s *stack) Middle() IStack {
-	s.IverticalAlign = "center"
+	s.IverticalAlign = css.AlignItemsCenter
 	return s
 }
 
 func (s *stack) Bottom() IStack {
-	s.IverticalAlign = "flex-end"
+	s.IverticalAlign = css.AlignItemsFlexEnd
 	return s
 }
 
 func (s *stack) Stretch() IStack {
-	s.IverticalAlign = "stretch"
+	s.IverticalAlign = css.AlignItemsStretch
 	return s
 }
 
@@ -129,9 +132,11 @@ func (s *stack) Render() app.UI {
 		DataSet("goapp", "Stack").
 		ID(s.Iid).
 		Class(s.Iclass).
-		Style("display", "flex").
-		Style("justify-content", s.IhorizontalAlign).
-		Style("align-items", s.IverticalAlign)
+		Styles(css.Styles().
+			Display(css.DisplayFlex).
+			JustifyContent(s.IhorizontalAlign).
+			AlignItems(s.IverticalAlign),
+		)
 
 	for _, s := range s.Istyles {
 		body.Style(s.key, s.value)
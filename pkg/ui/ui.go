@@ -35,10 +35,17 @@ var (
 
 	// The default width for flow items in px.
 	DefaultFlowItemWidth = 372
+
+	// The default estimated height for virtual list items in px.
+	DefaultVirtualListItemHeight = 48
 )
 
 const (
 	defaultHeaderHeight = 90
+
+	// The number of virtual list rows that fit the viewport assumed before the
+	// list is mounted, such as during pre-rendering.
+	defaultVirtualListRows = 12
 )
 
 func pxToString(px int) string {
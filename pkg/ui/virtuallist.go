@@ -0,0 +1,374 @@
+package ui
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/maxence-charriere/go-app/v9/pkg/app"
+)
+
+// IVirtualList is the interface that describes a list that only renders the
+// items visible in its scrolling area.
+type IVirtualList interface {
+	app.UI
+
+	// Sets the ID.
+	ID(v string) IVirtualList
+
+	// Sets the class. Multiple classes can be defined by successive calls.
+	Class(v string) IVirtualList
+
+	// Sets the estimated height in px of the items that are not rendered yet.
+	// Rendered items are measured, which allows items with variable heights.
+	// Default is 48px.
+	ItemHeight(px int) IVirtualList
+
+	// Sets the number of items rendered before and after the visible ones.
+	// Default is 3.
+	Overscan(n int) IVirtualList
+
+	// Sets the function that returns the key of the item at the given index.
+	// Keys identify items across updates, which keeps their measured height and
+	// their state when items are inserted or removed. Default is the item
+	// index.
+	Key(fn func(i int) string) IVirtualList
+
+	// Scrolls to the item at the given index when the index is different from
+	// the one set during the previous rendering.
+	ScrollToIndex(i int) IVirtualList
+
+	// Sets the number of items and the function that renders the item at the
+	// given index.
+	Items(count int, render func(i int) app.UI) IVirtualList
+}
+
+// VirtualList creates a list that only renders the items visible in its
+// scrolling area, plus a few ones around them. It is meant to display very
+// long collections.
+//
+// The scrolling area is the closest ancestor with a scrollable content, such
+// as the content of a Scroll, or the window when there is none.
+func VirtualList() IVirtualList {
+	return &virtualList{
+		IitemHeight:    DefaultVirtualListItemHeight,
+		Ioverscan:      3,
+		IscrollToIndex: -1,
+		id:             "goapp-virtual-list-" + uuid.NewString(),
+		heights:        make(map[string]int),
+		scrolledIndex:  -1,
+	}
+}
+
+type virtualList struct {
+	app.Compo
+
+	Iid            string
+	Iclass         string
+	IitemHeight    int
+	Ioverscan      int
+	Ikey           func(int) string
+	IscrollToIndex int
+	Icount         int
+	Irender        func(int) app.UI
+
+	id               string
+	ctx              app.Context
+	scroller         app.Value
+	scrollerIsWindow bool
+	onScroll         app.Func
+	heights          map[string]int
+	offsets          []int
+	start            int
+	end              int
+	scrolledIndex    int
+}
+
+func (l *virtualList) ID(v string) IVirtualList {
+	l.Iid = v
+	return l
+}
+
+func (l *virtualList) Class(v string) IVirtualList {
+	l.Iclass = app.AppendClass(l.Iclass, v)
+	return l
+}
+
+func (l *virtualList) ItemHeight(px int) IVirtualList {
+	if px > 0 {
+		l.IitemHeight = px
+	}
+	return l
+}
+
+func (l *virtualList) Overscan(n int) IVirtualList {
+	if n >= 0 {
+		l.Ioverscan = n
+	}
+	return l
+}
+
+func (l *virtualList) Key(fn func(i int) string) IVirtualList {
+	l.Ikey = fn
+	return l
+}
+
+func (l *virtualList) ScrollToIndex(i int) IVirtualList {
+	l.IscrollToIndex = i
+	return l
+}
+
+func (l *virtualList) Items(count int, render func(i int) app.UI) IVirtualList {
+	if render == nil {
+		count = 0
+	}
+	l.Icount = max(count, 0)
+	l.Irender = render
+	return l
+}
+
+func (l *virtualList) OnPreRender(ctx app.Context) {
+	l.layout()
+	l.refresh(ctx)
+}
+
+func (l *virtualList) OnMount(ctx app.Context) {
+	l.ctx = ctx
+	l.layout()
+	l.refresh(ctx)
+	ctx.Defer(l.listen)
+}
+
+func (l *virtualList) OnResize(ctx app.Context) {
+	l.refresh(ctx)
+	ctx.Defer(l.measure)
+}
+
+func (l *virtualList) OnUpdate(ctx app.Context) {
+	l.layout()
+	l.refresh(ctx)
+	ctx.Defer(l.scrollToIndex)
+}
+
+func (l *virtualList) OnDismount() {
+	if l.onScroll == nil {
+		return
+	}
+	l.scroller.Call("removeEventListener", "scroll", l.onScroll)
+	l.onScroll.Release()
+	l.onScroll = nil
+	l.scroller = nil
+}
+
+func (l *virtualList) Render() app.UI {
+	if len(l.offsets) != l.Icount+1 {
+		l.layout()
+	}
+	end := min(l.end, l.Icount)
+	start := min(l.start, end)
+
+	items := make([]app.UI, 0, end-start)
+	for i := start; i < end; i++ {
+		items = append(items, app.Div().
+			Key(l.key(i)).
+			DataSet("index", i).
+			Body(l.Irender(i)))
+	}
+
+	return app.Div().
+		DataSet("goapp-ui", "virtual-list").
+		ID(l.Iid).
+		Class(l.Iclass).
+		Body(
+			app.Div().
+				ID(l.id).
+				Style("position", "relative").
+				Style("width", "100%").
+				Style("height", pxToString(l.offsets[l.Icount])).
+				Body(
+					app.Div().
+						Style("position", "absolute").
+						Style("top", pxToString(l.offsets[start])).
+						Style("left", "0").
+						Style("width", "100%").
+						Body(items...),
+				),
+		)
+}
+
+// listen registers the scroll listener on the scrolling area. It is deferred
+// from OnMount because the list is not attached to the document when OnMount
+// is called.
+func (l *virtualList) listen(ctx app.Context) {
+	list := app.Window().GetElementByID(l.id)
+	if !list.Truthy() || l.onScroll != nil {
+		return
+	}
+
+	l.scroller, l.scrollerIsWindow = scrollParent(list)
+	l.onScroll = app.FuncOf(func(this app.Value, args []app.Value) any {
+		l.ctx.DispatchWithPriority(app.UserInputPriority, func(ctx app.Context) {
+			if !l.refresh(ctx) {
+				ctx.PreventUpdate()
+			}
+		})
+		return nil
+	})
+	l.scroller.Call("addEventListener", "scroll", l.onScroll)
+
+	if l.refresh(ctx) {
+		ctx.Dispatch(nil)
+	}
+	l.measure(ctx)
+	l.scrollToIndex(ctx)
+}
+
+// layout computes the offset of each item from the measured heights, or from
+// the estimated height when an item was not rendered yet. The heights of the
+// items that are no longer in the list are dropped.
+func (l *virtualList) layout() {
+	if cap(l.offsets) < l.Icount+1 {
+		l.offsets = make([]int, l.Icount+1)
+	}
+	l.offsets = l.offsets[:l.Icount+1]
+
+	measured := 0
+	for i := 0; i < l.Icount; i++ {
+		h, ok := l.heights[l.key(i)]
+		if ok {
+			measured++
+		} else {
+			h = l.IitemHeight
+		}
+		l.offsets[i+1] = l.offsets[i] + h
+	}
+
+	if measured == len(l.heights) {
+		return
+	}
+	keys := make(map[string]struct{}, l.Icount)
+	for i := 0; i < l.Icount; i++ {
+		keys[l.key(i)] = struct{}{}
+	}
+	for key := range l.heights {
+		if _, ok := keys[key]; !ok {
+			delete(l.heights, key)
+		}
+	}
+}
+
+// refresh computes the range of items to render and reports whether it
+// changed.
+func (l *virtualList) refresh(ctx app.Context) bool {
+	if !l.setRange(l.viewport()) {
+		return false
+	}
+	ctx.Defer(l.measure)
+	return true
+}
+
+// setRange sets the range of items to render for the given viewport, which
+// is described by the position of its top relative to the top of the list and
+// by its height. It reports whether the range changed.
+func (l *virtualList) setRange(top, height int) bool {
+	start := sort.Search(l.Icount, func(i int) bool {
+		return l.offsets[i+1] > top
+	})
+	end := sort.Search(l.Icount, func(i int) bool {
+		return l.offsets[i] >= top+height
+	})
+
+	start = max(start-l.Ioverscan, 0)
+	end = min(end+l.Ioverscan, l.Icount)
+	if start == l.start && end == l.end {
+		return false
+	}
+
+	l.start = start
+	l.end = end
+	return true
+}
+
+// measure stores the height of the rendered items and updates the list when
+// one of them differs from the previous one.
+func (l *virtualList) measure(ctx app.Context) {
+	list := app.Window().GetElementByID(l.id)
+	if !list.Truthy() {
+		return
+	}
+
+	var changed bool
+	items := list.Get("firstElementChild").Get("children")
+	for i, n := 0, items.Length(); i < n; i++ {
+		item := items.Index(i)
+		index, err := strconv.Atoi(item.Get("dataset").Get("index").String())
+		if err != nil || index >= l.Icount {
+			continue
+		}
+
+		key := l.key(index)
+		h := item.Get("offsetHeight").Int()
+		if l.heights[key] != h {
+			l.heights[key] = h
+			changed = true
+		}
+	}
+
+	if changed {
+		ctx.Dispatch(func(ctx app.Context) {
+			l.layout()
+			l.refresh(ctx)
+		})
+	}
+}
+
+func (l *virtualList) scrollToIndex(ctx app.Context) {
+	i := l.IscrollToIndex
+	if i == l.scrolledIndex {
+		return
+	}
+	l.scrolledIndex = i
+	if i < 0 || i >= l.Icount || l.scroller == nil {
+		return
+	}
+
+	top, _ := l.viewport()
+	l.scroller.Call("scrollBy", 0, l.offsets[i]-top)
+}
+
+// viewport returns the position of the top of the scrolling area relative to
+// the top of the list, and the height of the scrolling area.
+func (l *virtualList) viewport() (int, int) {
+	list := app.Window().GetElementByID(l.id)
+	if !list.Truthy() || l.scroller == nil {
+		return 0, defaultVirtualListRows * l.IitemHeight
+	}
+
+	listTop := list.Call("getBoundingClientRect").Get("top").Float()
+	if l.scrollerIsWindow {
+		return int(-listTop), app.Window().Get("innerHeight").Int()
+	}
+
+	scrollerTop := l.scroller.Call("getBoundingClientRect").Get("top").Float() +
+		l.scroller.Get("clientTop").Float()
+	return int(scrollerTop - listTop), l.scroller.Get("clientHeight").Int()
+}
+
+func (l *virtualList) key(i int) string {
+	if l.Ikey != nil {
+		return l.Ikey(i)
+	}
+	return strconv.Itoa(i)
+}
+
+// scrollParent returns the closest ancestor of the given element that has a
+// scrollable content, or the window when there is none.
+func scrollParent(elem app.Value) (app.Value, bool) {
+	for e := elem.Get("parentElement"); e.Truthy(); e = e.Get("parentElement") {
+		switch app.Window().Call("getComputedStyle", e).Get("overflowY").String() {
+		case "auto", "scroll", "overlay":
+			return e, false
+		}
+	}
+	return app.Window(), true
+}
@@ -0,0 +1,214 @@
+package ui
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/maxence-charriere/go-app/v9/pkg/app"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVirtualList(count int, heights map[string]int) *virtualList {
+	l := VirtualList().
+		ItemHeight(10).
+		Overscan(2).
+		Items(count, func(i int) app.UI {
+			return app.Text(i)
+		}).(*virtualList)
+
+	for k, h := range heights {
+		l.heights[k] = h
+	}
+	return l
+}
+
+func TestVirtualListLayout(t *testing.T) {
+	utests := []struct {
+		scenario        string
+		count           int
+		key             func(int) string
+		heights         map[string]int
+		expectedOffsets []int
+		expectedHeights map[string]int
+	}{
+		{
+			scenario:        "empty list",
+			expectedOffsets: []int{0},
+			expectedHeights: map[string]int{},
+		},
+		{
+			scenario:        "estimated heights",
+			count:           3,
+			expectedOffsets: []int{0, 10, 20, 30},
+			expectedHeights: map[string]int{},
+		},
+		{
+			scenario:        "measured heights",
+			count:           3,
+			heights:         map[string]int{"0": 5, "1": 25},
+			expectedOffsets: []int{0, 5, 30, 40},
+			expectedHeights: map[string]int{"0": 5, "1": 25},
+		},
+		{
+			scenario: "measured heights with keys",
+			count:    3,
+			key: func(i int) string {
+				return "item-" + strconv.Itoa(i)
+			},
+			heights:         map[string]int{"item-2": 30},
+			expectedOffsets: []int{0, 10, 20, 50},
+			expectedHeights: map[string]int{"item-2": 30},
+		},
+		{
+			scenario: "heights of removed items are dropped",
+			count:    2,
+			key: func(i int) string {
+				return []string{"b", "d"}[i]
+			},
+			heights:         map[string]int{"a": 15, "b": 20, "c": 25},
+			expectedOffsets: []int{0, 20, 30},
+			expectedHeights: map[string]int{"b": 20},
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			l := newTestVirtualList(u.count, u.heights)
+			l.Key(u.key)
+			l.layout()
+			require.Equal(t, u.expectedOffsets, l.offsets)
+			require.Equal(t, u.expectedHeights, l.heights)
+		})
+	}
+
+	t.Run("count decrease shrinks offsets", func(t *testing.T) {
+		l := newTestVirtualList(5, map[string]int{"4": 30})
+		l.layout()
+		require.Equal(t, []int{0, 10, 20, 30, 40, 70}, l.offsets)
+
+		l.Items(2, l.Irender)
+		l.layout()
+		require.Equal(t, []int{0, 10, 20}, l.offsets)
+		require.Empty(t, l.heights)
+	})
+}
+
+func TestVirtualListRefresh(t *testing.T) {
+	utests := []struct {
+		scenario      string
+		count         int
+		heights       map[string]int
+		overscan      int
+		top           int
+		height        int
+		expectedStart int
+		expectedEnd   int
+	}{
+		{
+			scenario: "empty list",
+			overscan: 2,
+			height:   50,
+		},
+		{
+			scenario:      "top with overscan clamped",
+			count:         100,
+			overscan:      2,
+			height:        50,
+			expectedStart: 0,
+			expectedEnd:   7,
+		},
+		{
+			scenario:      "middle with overscan",
+			count:         100,
+			overscan:      2,
+			top:           200,
+			height:        50,
+			expectedStart: 18,
+			expectedEnd:   27,
+		},
+		{
+			scenario:      "partially visible items",
+			count:         100,
+			top:           205,
+			height:        50,
+			expectedStart: 20,
+			expectedEnd:   26,
+		},
+		{
+			scenario:      "bottom with overscan clamped",
+			count:         100,
+			overscan:      2,
+			top:           960,
+			height:        50,
+			expectedStart: 94,
+			expectedEnd:   100,
+		},
+		{
+			scenario:      "variable heights",
+			count:         10,
+			heights:       map[string]int{"0": 100, "2": 40},
+			top:           105,
+			height:        20,
+			expectedStart: 1,
+			expectedEnd:   3,
+		},
+		{
+			scenario:      "viewport below the list",
+			count:         10,
+			overscan:      2,
+			top:           500,
+			height:        50,
+			expectedStart: 8,
+			expectedEnd:   10,
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			l := newTestVirtualList(u.count, u.heights)
+			l.Overscan(u.overscan)
+			l.layout()
+
+			changed := l.setRange(u.top, u.height)
+			require.Equal(t, u.expectedStart != 0 || u.expectedEnd != 0, changed)
+			require.Equal(t, u.expectedStart, l.start)
+			require.Equal(t, u.expectedEnd, l.end)
+		})
+	}
+
+	t.Run("unchanged range is reported", func(t *testing.T) {
+		l := newTestVirtualList(100, nil)
+		l.layout()
+		require.True(t, l.setRange(200, 50))
+		require.False(t, l.setRange(200, 49))
+		require.True(t, l.setRange(260, 50))
+	})
+
+	t.Run("count decrease below range", func(t *testing.T) {
+		l := newTestVirtualList(100, nil)
+		l.layout()
+		require.True(t, l.setRange(960, 50))
+		require.Equal(t, 94, l.start)
+		require.Equal(t, 100, l.end)
+
+		l.Items(50, l.Irender)
+		l.layout()
+		require.True(t, l.setRange(960, 50))
+		require.Equal(t, 48, l.start)
+		require.Equal(t, 50, l.end)
+	})
+
+	t.Run("count decrease within range", func(t *testing.T) {
+		l := newTestVirtualList(100, nil)
+		l.layout()
+		require.True(t, l.setRange(200, 50))
+		require.Equal(t, 18, l.start)
+		require.Equal(t, 27, l.end)
+
+		l.Items(22, l.Irender)
+		l.layout()
+		require.True(t, l.setRange(200, 50))
+		require.Equal(t, 18, l.start)
+		require.Equal(t, 22, l.end)
+	})
+}